@@ -0,0 +1,118 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package claimcheck offloads large Temporal payloads to a blob store, and
+// replaces them with references ("claim checks"), which are much smaller than
+// the payload size limits of Temporal. Generated data converters use it via
+// [NewCodec], with a [BlobStore] which the application supplies, such as
+// [FileStore] for development and tests.
+package claimcheck
+
+import (
+	"context"
+	"fmt"
+
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+const (
+	// MetadataEncoding is the metadata key of payload encodings, as in
+	// https://pkg.go.dev/go.temporal.io/sdk/converter#MetadataEncoding.
+	MetadataEncoding = "encoding"
+
+	// MetadataEncodingClaimCheck is the encoding of payloads which contain
+	// a claim check (a blob store key) instead of the original payload.
+	MetadataEncodingClaimCheck = "binary/claim-check"
+)
+
+// BlobStore stores and retrieves the data of large payloads.
+type BlobStore interface {
+	// Put stores the given data, and returns a key to retrieve it.
+	Put(ctx context.Context, data []byte) (string, error)
+	// Get returns the data which is stored with the given key.
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// PayloadCodec is identical to the PayloadCodec interface of the Temporal
+// Go SDK (https://pkg.go.dev/go.temporal.io/sdk/converter), so
+// implementations of each interface also implement the other one.
+type PayloadCodec interface {
+	// Encode encodes payloads.
+	Encode([]*commonpb.Payload) ([]*commonpb.Payload, error)
+	// Decode decodes payloads.
+	Decode([]*commonpb.Payload) ([]*commonpb.Payload, error)
+}
+
+type codec struct {
+	store     BlobStore
+	threshold int
+}
+
+// NewCodec returns a payload codec which offloads payloads whose (marshaled)
+// size exceeds the given threshold to the given blob store, and restores them.
+func NewCodec(store BlobStore, threshold int) PayloadCodec {
+	return &codec{store, threshold}
+}
+
+func (c *codec) Encode(payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	result := make([]*commonpb.Payload, len(payloads))
+	for i, p := range payloads {
+		if p.Size() <= c.threshold {
+			result[i] = p
+			continue
+		}
+		data, err := p.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		key, err := c.store.Put(context.Background(), data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to offload a large payload: %w", err)
+		}
+		result[i] = &commonpb.Payload{
+			Metadata: map[string][]byte{MetadataEncoding: []byte(MetadataEncodingClaimCheck)},
+			Data:     []byte(key),
+		}
+	}
+	return result, nil
+}
+
+func (c *codec) Decode(payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	result := make([]*commonpb.Payload, len(payloads))
+	for i, p := range payloads {
+		if string(p.GetMetadata()[MetadataEncoding]) != MetadataEncodingClaimCheck {
+			result[i] = p
+			continue
+		}
+		data, err := c.store.Get(context.Background(), string(p.Data))
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve a large payload: %w", err)
+		}
+		result[i] = &commonpb.Payload{}
+		if err := result[i].Unmarshal(data); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal a large payload: %w", err)
+		}
+	}
+	return result, nil
+}
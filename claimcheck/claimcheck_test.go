@@ -0,0 +1,110 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package claimcheck
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+func TestCodec(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCodec(store, 100)
+
+	small := &commonpb.Payload{
+		Metadata: map[string][]byte{MetadataEncoding: []byte("json/plain")},
+		Data:     []byte(`"small"`),
+	}
+	large := &commonpb.Payload{
+		Metadata: map[string][]byte{MetadataEncoding: []byte("binary/protobuf")},
+		Data:     bytes.Repeat([]byte("x"), 1000),
+	}
+
+	encoded, err := c.Encode([]*commonpb.Payload{small, large})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !encoded[0].Equal(small) {
+		t.Errorf("Encode() changed a small payload: %v", encoded[0])
+	}
+	if got := string(encoded[1].Metadata[MetadataEncoding]); got != MetadataEncodingClaimCheck {
+		t.Errorf("Encode() large payload encoding = %q, want %q", got, MetadataEncodingClaimCheck)
+	}
+	if encoded[1].Size() > 100 {
+		t.Errorf("Encode() large payload size = %d, want at most 100", encoded[1].Size())
+	}
+	if _, err := os.Stat(filepath.Join(dir, string(encoded[1].Data))); err != nil {
+		t.Errorf("large payload isn't in the file store: %v", err)
+	}
+
+	decoded, err := c.Decode(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decoded[0].Equal(small) || !decoded[1].Equal(large) {
+		t.Errorf("Decode() = %v, want the original payloads", decoded)
+	}
+}
+
+func TestCodecMissingBlob(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCodec(store, 0)
+	encoded, err := c.Encode([]*commonpb.Payload{{Data: []byte("data")}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewCodec(other, 0).Decode(encoded); err == nil {
+		t.Error("Decode() of a missing blob succeeded, want an error")
+	}
+}
+
+func TestFileStoreInvalidKey(t *testing.T) {
+	store, err := NewFileStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"", "../etc/passwd", "abcd"} {
+		if _, err := store.Get(context.Background(), key); err == nil {
+			t.Errorf("Get(%q) succeeded, want an error", key)
+		}
+	}
+}
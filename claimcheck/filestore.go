@@ -0,0 +1,81 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package claimcheck
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// FileStore is a [BlobStore] in a local directory, for development and
+// tests. Its keys are the SHA-256 hashes of the stored data, so storing
+// the same data more than once doesn't consume more space.
+type FileStore struct {
+	dir string
+}
+
+// NewFileStore returns a blob store in the given directory, which is created
+// if it doesn't exist yet.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileStore{dir}, nil
+}
+
+func (s *FileStore) Put(_ context.Context, data []byte) (string, error) {
+	hash := sha256.Sum256(data)
+	key := hex.EncodeToString(hash[:])
+
+	// Write to a temporary file first, so concurrent readers never
+	// see partially-written data.
+	f, err := os.CreateTemp(s.dir, key+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	if err := os.Rename(f.Name(), filepath.Join(s.dir, key)); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+func (s *FileStore) Get(_ context.Context, key string) ([]byte, error) {
+	if b, err := hex.DecodeString(key); err != nil || len(b) != sha256.Size {
+		return nil, fmt.Errorf("invalid key %q", key)
+	}
+	return os.ReadFile(filepath.Join(s.dir, key))
+}
@@ -51,12 +51,15 @@ import (
 	"fmt"
 
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
 
 	"github.com/daabr/protoc-gen-temporal-go/internal/generator"
 )
 
 const (
-	filenameSuffix = "_temporal.pb.go"
+	supportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL |
+		pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
 )
 
 func main() {
@@ -67,13 +70,46 @@ func main() {
 		return
 	}
 
-	protogen.Options{}.Run(func(p *protogen.Plugin) error {
+	cfg := generator.NewConfig()
+	protogen.Options{ParamFunc: cfg.Set}.Run(func(p *protogen.Plugin) error {
+		// The generated code refers only to message types as a whole, not to
+		// their fields, so proto3 "optional" fields and editions features
+		// (e.g. "field_presence") affect only the code of protoc-gen-go.
+		p.SupportedFeatures = supportedFeatures
+		p.SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
+		p.SupportedEditionsMaximum = descriptorpb.Edition_EDITION_2023
+
+		if err := cfg.Validate(); err != nil {
+			return err
+		}
+		if err := generator.ValidateTaskQueues(p.Files); err != nil {
+			return err
+		}
+		if err := generator.ValidateRetryPolicies(p.Files); err != nil {
+			return err
+		}
+
+		idx := generator.NewIndex(p.Files)
 		v := protocVersion(p)
+		var servicesFiles []*protogen.File
 		for _, f := range p.Files {
 			if !f.Generate {
 				continue
 			}
-			generateFile(p, f, v)
+			if _, err := generateFile(p, f, v, cfg, idx); err != nil {
+				return err
+			}
+			if len(f.Services) > 0 {
+				servicesFiles = append(servicesFiles, f)
+			}
+		}
+		if cfg.DiagramAggregate != "" {
+			g := p.NewGeneratedFile(cfg.DiagramAggregate, "")
+			generator.GenerateDiagram(g, servicesFiles, cfg.DiagramAggregateFormat(), idx)
+		}
+		if cfg.ManifestAggregate != "" {
+			g := p.NewGeneratedFile(cfg.ManifestAggregate, "")
+			return generator.GenerateManifest(g, servicesFiles, idx)
 		}
 		return nil
 	})
@@ -91,16 +127,55 @@ func protocVersion(gen *protogen.Plugin) string {
 	return s
 }
 
-func generateFile(p *protogen.Plugin, f *protogen.File, ver string) *protogen.GeneratedFile {
-	if len(f.Services) == 0 {
-		return nil
+func generateFile(p *protogen.Plugin, f *protogen.File, ver string, cfg *generator.Config, idx *generator.Index) (*protogen.GeneratedFile, error) {
+	if err := generator.ValidateSensitiveFields(f); err != nil {
+		return nil, err
 	}
-	filename := f.GeneratedFilenamePrefix + filenameSuffix
+	if len(f.Services) == 0 && !generator.HasRetryPolicies(f) {
+		return nil, nil
+	}
+	for _, service := range f.Services {
+		if err := generator.ValidateService(service, idx); err != nil {
+			return nil, err
+		}
+	}
+	filename := f.GeneratedFilenamePrefix + cfg.FilenameSuffix
 	g := p.NewGeneratedFile(filename, f.GoImportPath)
-	generator.GenerateHeader(g, f, ver)
+	generator.GenerateHeader(g, f, ver, cfg)
+	generator.GenerateRetryPolicies(g, f)
 	for _, service := range f.Services {
-		generator.GenerateWorker(g, service)
-		generator.GenerateClient(g, service)
+		if cfg.Worker {
+			generator.GenerateWorker(g, service)
+		}
+		generator.GenerateDataConverter(g, service)
+		generator.GenerateInputValidation(g, service, cfg)
+		generator.GenerateTracing(g, service)
+		generator.GenerateMetrics(g, service)
+		generator.GenerateClient(g, service, cfg, idx)
+		generator.GenerateHeartbeats(g, service, cfg, idx)
+		generator.GenerateErrors(g, service, cfg, idx)
+		generator.GenerateContinueAsNew(g, service, cfg)
+		generator.GenerateVersionChanges(g, service, cfg)
+		generator.GenerateGRPCServer(g, service, cfg)
+		generator.GenerateCLI(g, service, cfg)
+		if cfg.CLI && generator.HasCLICommand(service) {
+			c := p.NewGeneratedFile(generator.CLICommandPath(f, service), generator.CLICommandImportPath(f, service))
+			generator.GenerateCLICommand(c, f, service, ver, cfg)
+		}
+	}
+	if cfg.Docs && len(f.Services) > 0 {
+		d := p.NewGeneratedFile(f.GeneratedFilenamePrefix+generator.DocsFilenameSuffix, "")
+		generator.GenerateDocs(d, f, cfg, idx)
+	}
+	if cfg.Diagram != generator.DiagramNone && len(f.Services) > 0 {
+		d := p.NewGeneratedFile(f.GeneratedFilenamePrefix+cfg.Diagram.FilenameSuffix(), "")
+		generator.GenerateDiagram(d, []*protogen.File{f}, cfg.Diagram, idx)
+	}
+	if cfg.Manifest && len(f.Services) > 0 {
+		m := p.NewGeneratedFile(f.GeneratedFilenamePrefix+generator.ManifestFilenameSuffix, "")
+		if err := generator.GenerateManifest(m, []*protogen.File{f}, idx); err != nil {
+			return nil, err
+		}
 	}
-	return g
+	return g, nil
 }
@@ -26,6 +26,9 @@ package main
 
 import (
 	"flag"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"io/fs"
 	"os"
 	"os/exec"
@@ -34,6 +37,8 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+
+	"github.com/daabr/protoc-gen-temporal-go/internal/generator"
 )
 
 // When this environment variable is set, we skip running tests and instead
@@ -44,6 +49,20 @@ const runtimeMode = "RUN_MAIN_INSTEAD_OF_TESTS"
 // Use --regenerate to regenerate the golden .pb.go files.
 var regenerate = flag.Bool("regenerate", false, "regenerate golden files")
 
+const (
+	// Test cases with this prefix are expected to fail, with the error
+	// message in a golden file with the errorSuffix, instead of .pb.go files.
+	invalidPrefix = "invalid_"
+	errorSuffix   = ".error"
+
+	// Test cases may have a file with this suffix, containing additional
+	// plugin parameters ("<name>=<value>"), one per line.
+	paramsSuffix = ".params"
+
+	// Protoc reports errors from the plugin with this prefix.
+	pluginErrorPrefix = "--temporal-go_out: "
+)
+
 func init() {
 	if _, ok := os.LookupEnv(runtimeMode); ok {
 		main()
@@ -73,21 +92,49 @@ func TestMain(t *testing.T) {
 	}
 
 	// Run all test cases (compile all input proto files, compare output files
-	// to pre-compiled golden pb.go files).
+	// to pre-compiled golden pb.go files, or errors to golden error files).
 	for _, proto := range tests {
 		name := strings.TrimSuffix(filepath.Base(proto), ".proto")
 		t.Run(name, func(t *testing.T) {
-			runProtoc(t, proto, workDir)
-			got := readOutputFile(t, proto, workDir)
+			if strings.HasPrefix(name, invalidPrefix) {
+				out := runProtoc(t, proto, workDir, true)
+				got := readPluginError(t, proto, out)
+				want := readGoldenError(t, proto)
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("error mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+
+			// Each test case has its own output directory, which contains
+			// only its own (Go and non-Go) output files.
+			outDir, err := os.MkdirTemp(workDir, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			runProtoc(t, proto, outDir, false)
+			got := readOutputFile(t, proto, outDir)
 			want := readGoldenFile(t, proto)
 			if diff := cmp.Diff(want, got); diff != "" {
 				t.Errorf("content mismatch (-want +got):\n%s", diff)
 			}
+			checkImports(t, filepath.Base(proto), got)
+
+			for _, name := range extraOutputNames(t, proto, outDir) {
+				got := readExtraOutputFile(t, proto, outDir, name)
+				want := readGoldenExtraFile(t, proto, name)
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("%s mismatch (-want +got):\n%s", name, diff)
+				}
+				if strings.HasSuffix(name, ".go") {
+					checkImports(t, name, got)
+				}
+			}
 		})
 	}
 }
 
-func runProtoc(t *testing.T, inputProtoFile, workDir string) {
+func runProtoc(t *testing.T, inputProtoFile, workDir string, wantErr bool) string {
 	ex, err := os.Executable()
 	if err != nil {
 		t.Fatal(err)
@@ -100,8 +147,11 @@ func runProtoc(t *testing.T, inputProtoFile, workDir string) {
 		"--proto_path=../../proto",                     // .../temporal/worker.proto
 		"--proto_path=../../submodules/temporalio/api", // .../temporal/...
 		"--proto_path=" + filepath.Dir(inputProtoFile),
-		inputProtoFile,
 	}
+	for _, param := range readParams(t, inputProtoFile) {
+		args = append(args, "--temporal-go_opt="+param)
+	}
+	args = append(args, inputProtoFile)
 	cmd := exec.Command("protoc", args...)
 	cmd.Env = append(os.Environ(), runtimeMode+"=1")
 	t.Log("running:\n", strings.Join(cmd.Args, "\n"))
@@ -109,13 +159,20 @@ func runProtoc(t *testing.T, inputProtoFile, workDir string) {
 	if len(out) > 0 {
 		t.Logf("protoc output:\n%s", out)
 	}
+	if wantErr {
+		if err == nil {
+			t.Fatal("protoc succeeded, want an error")
+		}
+		return string(out)
+	}
 	if err != nil {
 		t.Fatal("protoc error:\n", err)
 	}
+	return string(out)
 }
 
 func readGoldenFile(t *testing.T, inputProtoFile string) string {
-	name := strings.TrimSuffix(inputProtoFile, ".proto") + filenameSuffix
+	name := strings.TrimSuffix(inputProtoFile, ".proto") + generator.DefaultFilenameSuffix
 	b, err := os.ReadFile(name)
 	if err != nil {
 		t.Log("golden file not found: ", name)
@@ -128,8 +185,8 @@ func readGoldenFile(t *testing.T, inputProtoFile string) string {
 }
 
 func readOutputFile(t *testing.T, inputProtoFile, workDir string) string {
-	goldenName := strings.TrimSuffix(inputProtoFile, ".proto") + filenameSuffix
-	name := strings.TrimSuffix(filepath.Base(inputProtoFile), ".proto") + filenameSuffix
+	goldenName := strings.TrimSuffix(inputProtoFile, ".proto") + generator.DefaultFilenameSuffix
+	name := strings.TrimSuffix(filepath.Base(inputProtoFile), ".proto") + generator.DefaultFilenameSuffix
 	name = filepath.Join(workDir, name)
 	b, err := os.ReadFile(name)
 	if err != nil {
@@ -149,3 +206,136 @@ func readOutputFile(t *testing.T, inputProtoFile, workDir string) string {
 	}
 	return s
 }
+
+func readParams(t *testing.T, inputProtoFile string) []string {
+	b, err := os.ReadFile(strings.TrimSuffix(inputProtoFile, ".proto") + paramsSuffix)
+	if err != nil {
+		return nil
+	}
+	return strings.Fields(string(b))
+}
+
+// extraOutputNames returns the paths (relative to the output directory) of
+// the additional output files of a test case, e.g. docs and manifests: the
+// standard ones, which may or may not have golden files, and any other file
+// which protoc generated for the test case.
+func extraOutputNames(t *testing.T, inputProtoFile, outDir string) []string {
+	base := strings.TrimSuffix(filepath.Base(inputProtoFile), ".proto")
+	names := []string{
+		base + generator.DocsFilenameSuffix,
+		base + generator.ManifestFilenameSuffix,
+		base + generator.DiagramMermaid.FilenameSuffix(),
+		base + generator.DiagramDOT.FilenameSuffix(),
+	}
+	seen := map[string]bool{base + generator.DefaultFilenameSuffix: true}
+	for _, name := range names {
+		seen[name] = true
+	}
+	err := filepath.WalkDir(outDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		name, err := filepath.Rel(outDir, path)
+		if err != nil {
+			return err
+		}
+		if !seen[name] {
+			names = append(names, name)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return names
+}
+
+func readGoldenExtraFile(t *testing.T, inputProtoFile, name string) string {
+	b, err := os.ReadFile(filepath.Join(filepath.Dir(inputProtoFile), name))
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func readExtraOutputFile(t *testing.T, inputProtoFile, outDir, name string) string {
+	goldenName := filepath.Join(filepath.Dir(inputProtoFile), name)
+	b, err := os.ReadFile(filepath.Join(outDir, name))
+	if err != nil {
+		if *regenerate {
+			os.Remove(goldenName)
+		}
+		return ""
+	}
+	t.Logf("got %s:\n%s", name, b)
+	if *regenerate {
+		if err := os.MkdirAll(filepath.Dir(goldenName), 0o755); err != nil {
+			t.Error(err)
+		}
+		if err := os.WriteFile(goldenName, b, 0o644); err != nil {
+			t.Error(err)
+		}
+	}
+	return string(b)
+}
+
+func readGoldenError(t *testing.T, inputProtoFile string) string {
+	name := strings.TrimSuffix(inputProtoFile, ".proto") + errorSuffix
+	b, err := os.ReadFile(name)
+	if err != nil {
+		t.Log("golden file not found: ", name)
+		return ""
+	}
+	return strings.TrimSpace(string(b))
+}
+
+func readPluginError(t *testing.T, inputProtoFile, protocOutput string) string {
+	s := ""
+	for _, line := range strings.Split(protocOutput, "\n") {
+		if strings.HasPrefix(line, pluginErrorPrefix) {
+			s = strings.TrimPrefix(line, pluginErrorPrefix)
+			break
+		}
+	}
+	if *regenerate {
+		goldenName := strings.TrimSuffix(inputProtoFile, ".proto") + errorSuffix
+		if err := os.WriteFile(goldenName, []byte(s+"\n"), 0o644); err != nil {
+			t.Error(err)
+		}
+	}
+	return s
+}
+
+// checkImports reports imports which the given generated Go file doesn't use,
+// because the Go compiler rejects them. Generated files import packages only
+// when they refer to them, so this catches references which are registered
+// but not generated, e.g. in code paths which are specific to activities.
+func checkImports(t *testing.T, name, src string) {
+	if src == "" {
+		return
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), name, src, 0)
+	if err != nil {
+		t.Errorf("%s: %v", name, err)
+		return
+	}
+	used := map[string]bool{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+	for _, imp := range f.Imports {
+		path := strings.Trim(imp.Path.Value, `"`)
+		pkg := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			pkg = imp.Name.Name
+		}
+		if pkg != "_" && pkg != "." && !used[pkg] {
+			t.Errorf("%s: %q is imported and not used", name, path)
+		}
+	}
+}
@@ -25,56 +25,54 @@ SOFTWARE.
 package generator
 
 import (
-	"fmt"
-
 	"google.golang.org/protobuf/compiler/protogen"
 )
 
-func startActivity(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string) {
+func startActivity(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string, idx *Index) {
 	comment := []string{
 		"This method starts the activity with pre-configured options, and returns a",
 		"Future to interact with it until completion. For more information, see",
 		"https://docs.temporal.io/dev-guide/go/foundations#activity-execution.",
 	}
 	ctx := g.QualifiedGoIdent(workflowPackage.Ident("Context"))
-	in := g.QualifiedGoIdent(method.Input.GoIdent)
+	in := inputParam(g, method)
 	out := g.QualifiedGoIdent(workflowPackage.Ident("Future"))
 
 	executePrefix(g, method, comment, structName, "StartActivity", serviceName, ctx, in, out)
+	validateInput(g, method, failedFuture(g)...)
 
 	g.P("ctx = ", workflowPackage.Ident("WithActivityOptions"), "(ctx, ", workflowPackage.Ident("ActivityOptions"), "{")
-	nonDefaultActivityOptions(g, method)
+	nonDefaultActivityOptions(g, method, idx)
 	g.P("})")
 
-	g.P("return ", workflowPackage.Ident("ExecuteActivity"), "(ctx, c.", method.GoName, ", in)")
+	g.P("return ", workflowPackage.Ident("ExecuteActivity"), "(ctx, c.", method.GoName, inputArg(method), ")")
 	g.P("}")
 	g.P()
 }
 
-func executeActivity(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string) {
+func executeActivity(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string, idx *Index) {
 	comment := []string{
 		"This method executes the activity with pre-configured options, blocks until",
 		"completion, and returns the output/error results. For more information, see",
 		"https://docs.temporal.io/dev-guide/go/foundations#activity-execution.",
 	}
 	ctx := g.QualifiedGoIdent(workflowPackage.Ident("Context"))
-	in := g.QualifiedGoIdent(method.Input.GoIdent)
-	out := fmt.Sprintf("(*%s, error)", g.QualifiedGoIdent(method.Output.GoIdent))
+	in := inputParam(g, method)
+	out := outputResult(g, method)
 
 	executePrefix(g, method, comment, structName, "ExecuteActivity", serviceName, ctx, in, out)
+	validateInput(g, method, errorReturn(method))
 
 	g.P("ctx = ", workflowPackage.Ident("WithActivityOptions"), "(ctx, ", workflowPackage.Ident("ActivityOptions"), "{")
-	nonDefaultActivityOptions(g, method)
+	nonDefaultActivityOptions(g, method, idx)
 	g.P("})")
 
-	g.P("var out *", g.QualifiedGoIdent(method.Output.GoIdent))
-	g.P("err := ", workflowPackage.Ident("ExecuteActivity"), "(ctx, c.", method.GoName, ", in).Get(ctx, &out)")
-	g.P("return out, err")
+	getOutput(g, method, g.QualifiedGoIdent(workflowPackage.Ident("ExecuteActivity"))+"(ctx, c."+method.GoName+inputArg(method)+")")
 	g.P("}")
 	g.P()
 }
 
-func startLocalActivity(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string) {
+func startLocalActivity(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string, idx *Index) {
 	comment := []string{
 		"This method starts the activity (locally) with pre-configured options, and",
 		"returns a Future to interact with it until completion. For more information,",
@@ -82,21 +80,22 @@ func startLocalActivity(g *protogen.GeneratedFile, method *protogen.Method, stru
 		"and https://docs.temporal.io/activities#local-activity.",
 	}
 	ctx := g.QualifiedGoIdent(workflowPackage.Ident("Context"))
-	in := g.QualifiedGoIdent(method.Input.GoIdent)
+	in := inputParam(g, method)
 	out := g.QualifiedGoIdent(workflowPackage.Ident("Future"))
 
 	executePrefix(g, method, comment, structName, "StartLocalActivity", serviceName, ctx, in, out)
+	validateInput(g, method, failedFuture(g)...)
 
 	g.P("ctx = ", workflowPackage.Ident("WithLocalActivityOptions"), "(ctx, ", workflowPackage.Ident("LocalActivityOptions"), "{")
-	nonDefaultLocalActivityOptions(g, method)
+	nonDefaultLocalActivityOptions(g, method, idx)
 	g.P("})")
 
-	g.P("return ", workflowPackage.Ident("ExecuteActivity"), "(ctx, c.", method.GoName, ", in)")
+	g.P("return ", workflowPackage.Ident("ExecuteActivity"), "(ctx, c.", method.GoName, inputArg(method), ")")
 	g.P("}")
 	g.P()
 }
 
-func executeLocalActivity(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string) {
+func executeLocalActivity(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string, idx *Index) {
 	comment := []string{
 		"This method executes the activity (locally) with pre-configured options,",
 		"blocks until completion, and returns the output/error. For more information,",
@@ -104,26 +103,81 @@ func executeLocalActivity(g *protogen.GeneratedFile, method *protogen.Method, st
 		"and https://docs.temporal.io/activities#local-activity.",
 	}
 	ctx := g.QualifiedGoIdent(workflowPackage.Ident("Context"))
-	in := g.QualifiedGoIdent(method.Input.GoIdent)
-	out := fmt.Sprintf("(*%s, error)", g.QualifiedGoIdent(method.Output.GoIdent))
+	in := inputParam(g, method)
+	out := outputResult(g, method)
 
 	executePrefix(g, method, comment, structName, "ExecuteLocalActivity", serviceName, ctx, in, out)
+	validateInput(g, method, errorReturn(method))
 
 	g.P("ctx = ", workflowPackage.Ident("WithLocalActivityOptions"), "(ctx, ", workflowPackage.Ident("LocalActivityOptions"), "{")
-	nonDefaultLocalActivityOptions(g, method)
+	nonDefaultLocalActivityOptions(g, method, idx)
 	g.P("})")
 
-	g.P("var out *", g.QualifiedGoIdent(method.Output.GoIdent))
-	g.P("err := ", workflowPackage.Ident("ExecuteLocalActivity"), "(ctx, c.", method.GoName, ", in).Get(ctx, &out)")
-	g.P("return out, err")
+	getOutput(g, method, g.QualifiedGoIdent(workflowPackage.Ident("ExecuteLocalActivity"))+"(ctx, c."+method.GoName+inputArg(method)+")")
 	g.P("}")
 	g.P()
 }
 
-func nonDefaultActivityOptions(g *protogen.GeneratedFile, method *protogen.Method) {
-	// TODO
+func nonDefaultActivityOptions(g *protogen.GeneratedFile, method *protogen.Method, idx *Index) {
+	o := activityOptions(method)
+	nonDefaultOptions(g, []option{
+		{
+			taskQueue(method, o.TaskQueue),
+			"TaskQueue",
+		},
+		{
+			o.ScheduleToCloseTimeout,
+			"ScheduleToCloseTimeout",
+		},
+		{
+			o.ScheduleToStartTimeout,
+			"ScheduleToStartTimeout",
+		},
+		{
+			o.StartToCloseTimeout,
+			"StartToCloseTimeout",
+		},
+		{
+			o.HeartbeatTimeout,
+			"HeartbeatTimeout",
+		},
+		{
+			o.WaitForCancellation,
+			"WaitForCancellation",
+		},
+		{
+			o.ActivityId,
+			"ActivityID",
+		},
+		{
+			idx.retryPolicyOption(method, o.GetRetryPolicyRef(), o.GetRetryPolicy()),
+			"RetryPolicy",
+		},
+		{
+			o.DisableEagerExecution,
+			"DisableEagerExecution",
+		},
+		{
+			o.VersioningIntent,
+			"VersioningIntent",
+		},
+	})
 }
 
-func nonDefaultLocalActivityOptions(g *protogen.GeneratedFile, method *protogen.Method) {
-	// TODO
+func nonDefaultLocalActivityOptions(g *protogen.GeneratedFile, method *protogen.Method, idx *Index) {
+	o := activityOptions(method)
+	nonDefaultOptions(g, []option{
+		{
+			o.ScheduleToCloseTimeout,
+			"ScheduleToCloseTimeout",
+		},
+		{
+			o.StartToCloseTimeout,
+			"StartToCloseTimeout",
+		},
+		{
+			idx.retryPolicyOption(method, o.GetRetryPolicyRef(), o.GetRetryPolicy()),
+			"RetryPolicy",
+		},
+	})
 }
@@ -0,0 +1,386 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+// GenerateCLI generates a function which implements a command-line interface
+// of the given service, to start and inspect its workflows, if it has any.
+// See also [GenerateCLICommand].
+func GenerateCLI(g *protogen.GeneratedFile, service *protogen.Service, cfg *Config) {
+	if !cfg.CLI || !hasWorkflows(service) {
+		return
+	}
+
+	name := service.GoName
+	usage := name + "CLIUsage"
+	g.P("// ", usage, " describes the command-line interface of ", name, ", see Run", name, "CLI.")
+	g.P("const ", usage, " = `Usage: <command> [flags] <subcommand> [subcommand flags]")
+	g.P()
+	g.P("Subcommands:")
+	g.P("  start <workflow> [-input <file>]           Start a workflow, and print its IDs")
+	g.P("  execute <workflow> [-input <file>]         Execute a workflow, and print its output")
+	g.P("  result <workflow> -id <id> [-run-id <id>]  Wait for a workflow's output, and print it")
+	g.P("  describe -id <id> [-run-id <id>]           Describe a workflow execution")
+	g.P("  cancel -id <id> [-run-id <id>]             Request to cancel a workflow execution")
+	g.P("  signal -id <id> [-run-id <id>] -name <signal> [-input <file>]")
+	g.P("  query <workflow> -id <id> [-run-id <id>] -name <query>")
+	g.P()
+	g.P("Workflows of ", service.Desc.FullName(), ":")
+	for _, method := range service.Methods {
+		if isWorkflow(method) {
+			g.P(fmt.Sprintf("  %s (input: %s, output: %s)", method.GoName, method.Input.Desc.FullName(), method.Output.Desc.FullName()))
+		}
+	}
+	g.P()
+	g.P("Workflow inputs are protojson, read from a file or from stdin (the default,")
+	g.P(`or "-"). Signal inputs are JSON, and are omitted unless -input is specified.`)
+	g.P("Workflow outputs and query results are printed as protojson (queries must")
+	g.P("return the output message of the workflow), and other outputs as JSON.`")
+	g.P()
+
+	cliRun(g, service, cfg)
+	cliWorkflows(g, service, cfg)
+	cliHelpers(g, service)
+}
+
+func cliRun(g *protogen.GeneratedFile, service *protogen.Service, cfg *Config) {
+	name := service.GoName
+	usage := name + "CLIUsage"
+	newClient := "new" + name + interfaceSuffix + "(c)"
+	if usesMetrics(service) {
+		newClient = "new" + name + interfaceSuffix + "(c, nil)"
+	}
+
+	g.P("// Run", name, "CLI runs the command-line interface of ", name, " (see ", usage, ")")
+	g.P("// with the given arguments, without the program name and its own flags. The")
+	g.P("// client should be created with the data converter of the workers, if any.")
+	if usesMetrics(service) {
+		g.P("// Client helpers don't record metrics in the command-line interface.")
+	}
+	if !HasCLICommand(service) {
+		g.P("//")
+		g.P("// There is no generated command for ", name, ", because it needs a key provider")
+		g.P("// or a blob store: call this function with a client from Dial", name, ".")
+	}
+	g.P("func Run", name, "CLI(ctx ", contextPackage.Ident("Context"), ", c ", clientPackage.Ident("Client"), ", args []string, stdin ", ioPackage.Ident("Reader"), ", stdout ", ioPackage.Ident("Writer"), ") error {")
+	g.P("if len(args) == 0 {")
+	g.P("return ", errorsPackage.Ident("New"), "(", usage, ")")
+	g.P("}")
+	g.P("cmd, args := args[0], args[1:]")
+	g.P("var wf string")
+	g.P("switch cmd {")
+	g.P(`case "start", "execute", "result", "query":`)
+	g.P(`if len(args) == 0 || `, stringsPackage.Ident("HasPrefix"), `(args[0], "-") {`)
+	g.P(`return `, fmtPackage.Ident("Errorf"), `("%s: missing workflow name\n\n%s", cmd, `, usage, ")")
+	g.P("}")
+	g.P("wf, args = args[0], args[1:]")
+	g.P("}")
+	g.P()
+	g.P("fs := ", flagPackage.Ident("NewFlagSet"), "(cmd, ", flagPackage.Ident("ContinueOnError"), ")")
+	g.P("fs.SetOutput(", ioPackage.Ident("Discard"), ")")
+	g.P(`input := fs.String("input", "", "input file, or \"-\" for stdin")`)
+	g.P(`id := fs.String("id", "", "workflow ID")`)
+	g.P(`runID := fs.String("run-id", "", "workflow run ID (default: the latest run)")`)
+	g.P(`name := fs.String("name", "", "signal or query name")`)
+	g.P("if err := fs.Parse(args); err != nil {")
+	g.P(`return `, fmtPackage.Ident("Errorf"), `("%s: %w\n\n%s", cmd, err, `, usage, ")")
+	g.P("}")
+	g.P("switch {")
+	g.P(`case *id == "" && cmd != "start" && cmd != "execute":`)
+	g.P(`return `, fmtPackage.Ident("Errorf"), `("%s: missing workflow ID (-id)", cmd)`)
+	g.P(`case *name == "" && (cmd == "signal" || cmd == "query"):`)
+	g.P(`return `, fmtPackage.Ident("Errorf"), `("%s: missing name (-name)", cmd)`)
+	g.P("}")
+	g.P()
+	g.P("switch cmd {")
+	g.P(`case "start", "execute", "result", "query":`)
+	g.P("return run", name, "CLIWorkflow(ctx, ", newClient, ", cmd, wf, *input, *id, *runID, *name, stdin, stdout)")
+	g.P(`case "describe":`)
+	g.P("resp, err := c.DescribeWorkflowExecution(ctx, *id, *runID)")
+	g.P("if err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("info := resp.GetWorkflowExecutionInfo()")
+	g.P("return write", name, "CLIJSON(stdout, map[string]interface{}{")
+	g.P(`"workflow_id": info.GetExecution().GetWorkflowId(),`)
+	g.P(`"run_id": info.GetExecution().GetRunId(),`)
+	g.P(`"type": info.GetType().GetName(),`)
+	g.P(`"status": info.GetStatus().String(),`)
+	g.P(`"task_queue": info.GetTaskQueue(),`)
+	g.P(`"start_time": info.GetStartTime(),`)
+	g.P(`"close_time": info.GetCloseTime(),`)
+	g.P(`"history_length": info.GetHistoryLength(),`)
+	g.P("})")
+	g.P(`case "cancel":`)
+	g.P("return c.CancelWorkflow(ctx, *id, *runID)")
+	g.P(`case "signal":`)
+	g.P("var arg interface{}")
+	g.P(`if *input != "" {`)
+	g.P("b, err := read", name, "CLIInput(*input, stdin)")
+	g.P("if err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("if err := ", jsonPackage.Ident("Unmarshal"), "(b, &arg); err != nil {")
+	g.P(`return `, fmtPackage.Ident("Errorf"), `("invalid signal input: %w", err)`)
+	g.P("}")
+	g.P("}")
+	g.P("return c.SignalWorkflow(ctx, *id, *runID, *name, arg)")
+	g.P("default:")
+	g.P(`return `, fmtPackage.Ident("Errorf"), `("unknown subcommand %q\n\n%s", cmd, `, usage, ")")
+	g.P("}")
+	g.P("}")
+	g.P()
+}
+
+// cliWorkflows generates the subcommands which use the workflow helpers.
+func cliWorkflows(g *protogen.GeneratedFile, service *protogen.Service, cfg *Config) {
+	name := service.GoName
+	prefix := cfg.helperPrefix(name)
+	structName := unexport(name + interfaceSuffix)
+
+	g.P("func run", name, "CLIWorkflow(ctx ", contextPackage.Ident("Context"), ", c *", structName, ", cmd, wf, input, id, runID, query string, stdin ", ioPackage.Ident("Reader"), ", stdout ", ioPackage.Ident("Writer"), ") error {")
+	g.P("switch wf {")
+	for _, method := range service.Methods {
+		if !isWorkflow(method) {
+			continue
+		}
+		g.P(`case "`, method.GoName, `":`)
+		g.P("switch cmd {")
+
+		g.P(`case "start":`)
+		cliReadInput(g, method)
+		g.P("run, err := c.StartWorkflow", prefix, method.GoName, "(ctx", inputArg(method), ")")
+		g.P("if err != nil {")
+		g.P("return err")
+		g.P("}")
+		g.P("return write", name, "CLIJSON(stdout, map[string]string{")
+		g.P(`"workflow_id": run.GetID(),`)
+		g.P(`"run_id": run.GetRunID(),`)
+		g.P("})")
+
+		g.P(`case "execute":`)
+		cliReadInput(g, method)
+		if isEmpty(method.Output) {
+			g.P("if err := c.ExecuteWorkflow", prefix, method.GoName, "(ctx", inputArg(method), "); err != nil {")
+			g.P("return err")
+			g.P("}")
+			g.P("return write", name, "CLIOutput(stdout, &", emptypbPackage.Ident("Empty"), "{})")
+		} else {
+			g.P("out, err := c.ExecuteWorkflow", prefix, method.GoName, "(ctx", inputArg(method), ")")
+			g.P("if err != nil {")
+			g.P("return err")
+			g.P("}")
+			g.P("return write", name, "CLIOutput(stdout, out)")
+		}
+
+		g.P(`case "query":`)
+		if isEmpty(method.Output) {
+			g.P("if _, err := c.t.QueryWorkflow(ctx, id, runID, query); err != nil {")
+			g.P("return err")
+			g.P("}")
+			g.P("return write", name, "CLIOutput(stdout, &", emptypbPackage.Ident("Empty"), "{})")
+		} else {
+			g.P("v, err := c.t.QueryWorkflow(ctx, id, runID, query)")
+			g.P("if err != nil {")
+			g.P("return err")
+			g.P("}")
+			g.P("var out *", method.Output.GoIdent)
+			g.P("if v.HasValue() {")
+			g.P("if err := v.Get(&out); err != nil {")
+			g.P("return err")
+			g.P("}")
+			g.P("}")
+			g.P("return write", name, "CLIOutput(stdout, out)")
+		}
+
+		g.P("default:")
+		if isEmpty(method.Output) {
+			g.P("if err := c.t.GetWorkflow(ctx, id, runID).Get(ctx, nil); err != nil {")
+			g.P("return err")
+			g.P("}")
+			g.P("return write", name, "CLIOutput(stdout, &", emptypbPackage.Ident("Empty"), "{})")
+		} else {
+			g.P("var out *", method.Output.GoIdent)
+			g.P("if err := c.t.GetWorkflow(ctx, id, runID).Get(ctx, &out); err != nil {")
+			g.P("return err")
+			g.P("}")
+			g.P("return write", name, "CLIOutput(stdout, out)")
+		}
+		g.P("}")
+	}
+	g.P("}")
+	g.P(`return `, fmtPackage.Ident("Errorf"), `("%s: unknown workflow %q\n\n%s", cmd, wf, `, name, "CLIUsage)")
+	g.P("}")
+	g.P()
+}
+
+func cliReadInput(g *protogen.GeneratedFile, method *protogen.Method) {
+	if isEmpty(method.Input) {
+		return
+	}
+	name := method.Parent.GoName
+	g.P("in := &", method.Input.GoIdent, "{}")
+	g.P("if err := read", name, "CLIMessage(input, stdin, in); err != nil {")
+	g.P("return err")
+	g.P("}")
+}
+
+func cliHelpers(g *protogen.GeneratedFile, service *protogen.Service) {
+	name := service.GoName
+
+	g.P("func read", name, "CLIInput(input string, stdin ", ioPackage.Ident("Reader"), ") ([]byte, error) {")
+	g.P("var b []byte")
+	g.P("var err error")
+	g.P(`if input == "" || input == "-" {`)
+	g.P("b, err = ", ioPackage.Ident("ReadAll"), "(stdin)")
+	g.P("} else {")
+	g.P("b, err = ", osPackage.Ident("ReadFile"), "(input)")
+	g.P("}")
+	g.P("if err != nil {")
+	g.P(`return nil, `, fmtPackage.Ident("Errorf"), `("failed to read input: %w", err)`)
+	g.P("}")
+	g.P("return b, nil")
+	g.P("}")
+	g.P()
+
+	g.P("func read", name, "CLIMessage(input string, stdin ", ioPackage.Ident("Reader"), ", m ", protoPackage.Ident("Message"), ") error {")
+	g.P("b, err := read", name, "CLIInput(input, stdin)")
+	g.P("if err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("if err := ", protojsonPackage.Ident("Unmarshal"), "(b, m); err != nil {")
+	g.P(`return `, fmtPackage.Ident("Errorf"), `("invalid input: %w", err)`)
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+
+	g.P("func write", name, "CLIOutput(stdout ", ioPackage.Ident("Writer"), ", m ", protoPackage.Ident("Message"), ") error {")
+	g.P("b, err := ", protojsonPackage.Ident("MarshalOptions"), "{Multiline: true}.Marshal(m)")
+	g.P("if err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("_, err = ", fmtPackage.Ident("Fprintln"), "(stdout, string(b))")
+	g.P("return err")
+	g.P("}")
+	g.P()
+
+	g.P("func write", name, "CLIJSON(stdout ", ioPackage.Ident("Writer"), ", v interface{}) error {")
+	g.P("e := ", jsonPackage.Ident("NewEncoder"), "(stdout)")
+	g.P(`e.SetIndent("", "  ")`)
+	g.P("return e.Encode(v)")
+	g.P("}")
+	g.P()
+}
+
+// CLICommandPath returns the path of the generated command of the given
+// service (see [GenerateCLICommand]), relative to the file's output path.
+func CLICommandPath(f *protogen.File, service *protogen.Service) string {
+	return path.Join(path.Dir(f.GeneratedFilenamePrefix), "cmd", strings.ToLower(service.GoName), "main.go")
+}
+
+// CLICommandImportPath returns the Go import path of the generated command
+// of the given service.
+func CLICommandImportPath(f *protogen.File, service *protogen.Service) protogen.GoImportPath {
+	return protogen.GoImportPath(path.Join(string(f.GoImportPath), "cmd", strings.ToLower(service.GoName)))
+}
+
+// GenerateCLICommand generates the main package of a command which connects
+// to Temporal and runs the command-line interface of the given service (see
+// [GenerateCLI]), if [HasCLICommand] reports that it can.
+func GenerateCLICommand(g *protogen.GeneratedFile, f *protogen.File, service *protogen.Service, ver string, cfg *Config) {
+	name := service.GoName
+	pkg := f.GoImportPath
+
+	generatedComment(g, f, ver, cfg)
+	g.P()
+	g.P("// Command ", strings.ToLower(name), " starts and inspects the workflows of ", name, ", with")
+	g.P("// inputs and outputs in protojson. Run it without arguments for usage details.")
+	g.P("package main")
+	g.P()
+
+	dial := clientPackage.Ident("Dial")
+	if hasPayloadEncoding(service) {
+		dial = pkg.Ident("Dial" + name)
+	}
+
+	g.P("func main() {")
+	g.P(`address := `, flagPackage.Ident("String"), `("address", `, clientPackage.Ident("DefaultHostPort"), `, "host:port of the Temporal frontend service")`)
+	g.P(`namespace := `, flagPackage.Ident("String"), `("namespace", `, clientPackage.Ident("DefaultNamespace"), `, "Temporal namespace")`)
+	g.P(flagPackage.Ident("Usage"), " = func() {")
+	g.P(fmtPackage.Ident("Fprintln"), "(", flagPackage.Ident("CommandLine"), ".Output(), ", pkg.Ident(name+"CLIUsage"), ")")
+	g.P(fmtPackage.Ident("Fprintln"), "(", flagPackage.Ident("CommandLine"), `.Output(), "\nFlags:")`)
+	g.P(flagPackage.Ident("PrintDefaults"), "()")
+	g.P("}")
+	g.P(flagPackage.Ident("Parse"), "()")
+	g.P("if ", flagPackage.Ident("NArg"), "() == 0 {")
+	g.P(flagPackage.Ident("Usage"), "()")
+	g.P(osPackage.Ident("Exit"), "(2)")
+	g.P("}")
+	g.P()
+	g.P("c, err := ", dial, "(", clientPackage.Ident("Options"), "{HostPort: *address, Namespace: *namespace})")
+	g.P("if err != nil {")
+	g.P(logPackage.Ident("Fatalln"), `("Failed to connect to Temporal:", err)`)
+	g.P("}")
+	g.P("defer c.Close()")
+	g.P()
+	g.P("ctx := ", contextPackage.Ident("Background"), "()")
+	g.P("if err := ", pkg.Ident("Run"+name+"CLI"), "(ctx, c, ", flagPackage.Ident("Args"), "(), ", osPackage.Ident("Stdin"), ", ", osPackage.Ident("Stdout"), "); err != nil {")
+	g.P(fmtPackage.Ident("Fprintln"), "(", osPackage.Ident("Stderr"), ", err)")
+	g.P("c.Close()")
+	g.P(osPackage.Ident("Exit"), "(1)")
+	g.P("}")
+	g.P("}")
+}
+
+// HasCLICommand reports whether [GenerateCLICommand] can generate a command
+// for the given service: it must have workflows, and must not need a key
+// provider for sensitive fields or a blob store for large payloads, since
+// the command can't create them. Such services still have a Run<Service>CLI
+// function, to call with a client from Dial<Service>.
+func HasCLICommand(service *protogen.Service) bool {
+	w := proto.GetExtension(service.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker)
+	return hasWorkflows(service) && !usesSensitiveFields(service) && w.GetLargePayloadThreshold() <= 0
+}
+
+// hasWorkflows reports whether any method of the given service is a workflow.
+func hasWorkflows(service *protogen.Service) bool {
+	for _, method := range service.Methods {
+		if isWorkflow(method) {
+			return true
+		}
+	}
+	return false
+}
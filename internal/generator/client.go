@@ -39,51 +39,87 @@ const (
 	interfaceSuffix = "TemporalClient"
 
 	deprecationComment = "// Deprecated: Do not use."
+
+	emptyFullName = "google.protobuf.Empty"
 )
 
-func GenerateClient(g *protogen.GeneratedFile, service *protogen.Service) {
+func GenerateClient(g *protogen.GeneratedFile, service *protogen.Service, cfg *Config, idx *Index) {
+	if !cfg.anyClientHelpers() {
+		return
+	}
+
 	interfaceName := service.GoName + interfaceSuffix
-	exportedInterface(g, service, interfaceName)
+	prefix := cfg.helperPrefix(service.GoName)
+	exportedInterface(g, service, interfaceName, prefix, idx)
 
 	// Private structure.
 	structName := unexport(interfaceName)
 	g.P("type ", structName, " struct {")
 	g.P("t ", clientPackage.Ident("Client"))
+	if usesMetrics(service) {
+		g.P("m ", clientPackage.Ident("MetricsHandler"))
+	}
 	g.P("}")
 	g.P()
 
 	// Client constructor.
 	serviceComments(g, service)
-	g.P("func New", interfaceName, "(c ", clientPackage.Ident("Client"), ") *", interfaceName, " {")
-	g.P("return &", structName, "{c}")
+	if usesMetrics(service) {
+		g.P("//")
+		g.P("// Client helpers record metrics with the given handler, which should be the")
+		g.P("// same as the client's MetricsHandler option (nil disables them). Helpers in")
+		g.P("// workflows use the workflow's handler instead.")
+		g.P("func New", interfaceName, "(c ", clientPackage.Ident("Client"), ", m ", clientPackage.Ident("MetricsHandler"), ") *", interfaceName, " {")
+		g.P("return new", interfaceName, "(c, m)")
+	} else {
+		g.P("func New", interfaceName, "(c ", clientPackage.Ident("Client"), ") *", interfaceName, " {")
+		g.P("return new", interfaceName, "(c)")
+	}
+	g.P("}")
+	g.P()
+
+	// Unexported constructor, which the gRPC server and the CLI also use.
+	if usesMetrics(service) {
+		g.P("func new", interfaceName, "(c ", clientPackage.Ident("Client"), ", m ", clientPackage.Ident("MetricsHandler"), ") *", structName, " {")
+		g.P("return &", structName, "{c, m}")
+	} else {
+		g.P("func new", interfaceName, "(c ", clientPackage.Ident("Client"), ") *", structName, " {")
+		g.P("return &", structName, "{c}")
+	}
 	g.P("}")
 	g.P()
 
 	// Helper methods for executing workflows and activities.
 	for _, method := range service.Methods {
 		if isWorkflow(method) {
-			startWorkflow(g, method, structName, service.GoName)
-			executeWorkflow(g, method, structName, service.GoName)
-
-			startChildWorkflow(g, method, structName, service.GoName)
-			executeChildWorkflow(g, method, structName, service.GoName)
+			if cfg.Client {
+				startWorkflow(g, method, structName, prefix, idx)
+				executeWorkflow(g, method, structName, prefix, idx)
+			}
+			if cfg.ChildWorkflows {
+				startChildWorkflow(g, method, structName, prefix, idx)
+				executeChildWorkflow(g, method, structName, prefix, idx)
+			}
 		} else {
-			startActivity(g, method, structName, service.GoName)
-			executeActivity(g, method, structName, service.GoName)
-
-			startLocalActivity(g, method, structName, service.GoName)
-			executeLocalActivity(g, method, structName, service.GoName)
+			if cfg.Activities {
+				startActivity(g, method, structName, prefix, idx)
+				executeActivity(g, method, structName, prefix, idx)
+			}
+			if cfg.LocalActivities {
+				startLocalActivity(g, method, structName, prefix, idx)
+				executeLocalActivity(g, method, structName, prefix, idx)
+			}
 		}
 	}
 }
 
-func exportedInterface(g *protogen.GeneratedFile, service *protogen.Service, interfaceName string) {
+func exportedInterface(g *protogen.GeneratedFile, service *protogen.Service, interfaceName, serviceName string, idx *Index) {
 	serviceComments(g, service)
 
 	g.Annotate(interfaceName, service.Location)
 	g.P("type ", interfaceName, " interface {", service.Comments.Trailing)
 	for _, m := range service.Methods {
-		methodSignature(g, m, interfaceName)
+		methodSignature(g, m, interfaceName, serviceName, idx)
 	}
 	g.P("}")
 	g.P()
@@ -100,8 +136,15 @@ func serviceComments(g *protogen.GeneratedFile, service *protogen.Service) {
 	}
 }
 
-func methodSignature(g *protogen.GeneratedFile, method *protogen.Method, interfaceName string) {
-	methodComment(g, method, nil)
+func methodSignature(g *protogen.GeneratedFile, method *protogen.Method, interfaceName, serviceName string, idx *Index) {
+	var comment []string
+	if details, _ := idx.heartbeatDetails(method); details != nil {
+		comment = []string{
+			fmt.Sprintf("This activity heartbeats with *%s details, using", g.QualifiedGoIdent(details.GoIdent)),
+			fmt.Sprintf("RecordHeartbeat%s%s and GetHeartbeat%[1]s%[2]s.", serviceName, method.GoName),
+		}
+	}
+	methodComment(g, method, comment)
 
 	p := contextPackage
 	if isWorkflow(method) {
@@ -109,11 +152,11 @@ func methodSignature(g *protogen.GeneratedFile, method *protogen.Method, interfa
 	}
 
 	ctx := g.QualifiedGoIdent(p.Ident("Context"))
-	in := g.QualifiedGoIdent(method.Input.GoIdent)
-	out := g.QualifiedGoIdent(method.Output.GoIdent)
+	in := inputParam(g, method)
+	out := outputResult(g, method)
 
 	g.Annotate(interfaceName+"."+method.GoName, method.Location)
-	s := fmt.Sprintf("%s(ctx %s, in *%s) (*%s, error)", method.GoName, ctx, in, out)
+	s := fmt.Sprintf("%s(ctx %s%s) %s", method.GoName, ctx, in, out)
 	g.P(s, method.Comments.Trailing)
 }
 
@@ -145,7 +188,62 @@ func unexport(s string) string {
 func executePrefix(g *protogen.GeneratedFile, method *protogen.Method, comment []string, structName, action, serviceName, ctx, in, out string) {
 	methodComment(g, method, comment)
 
-	s := "func (c *%s) %s%s%s(ctx %s, in *%s) %s {"
+	s := "func (c *%s) %s%s%s(ctx %s%s) %s {"
 	s = fmt.Sprintf(s, structName, action, serviceName, method.GoName, ctx, in, out)
 	g.P(s, method.Comments.Trailing)
 }
+
+// isEmpty reports whether the given message is "google.protobuf.Empty",
+// which the generated code omits from function signatures. This doesn't
+// affect compatibility with callers and workers that pass it explicitly:
+// Temporal ignores unexpected arguments, and leaves missing ones and missing
+// results with their zero values, i.e. nil pointers to Empty messages.
+func isEmpty(message *protogen.Message) bool {
+	return message.Desc.FullName() == emptyFullName
+}
+
+// inputParam returns the input parameter of a generated function, including
+// a leading comma, or an empty string if the method's input is Empty.
+func inputParam(g *protogen.GeneratedFile, method *protogen.Method) string {
+	if isEmpty(method.Input) {
+		return ""
+	}
+	return ", in *" + g.QualifiedGoIdent(method.Input.GoIdent)
+}
+
+// inputArg returns the input argument to pass to Temporal, including a
+// leading comma, or an empty string if the method's input is Empty.
+func inputArg(method *protogen.Method) string {
+	if isEmpty(method.Input) {
+		return ""
+	}
+	return ", in"
+}
+
+// outputResult returns the results of a generated function which blocks until
+// completion: only an error if the method's output is Empty.
+func outputResult(g *protogen.GeneratedFile, method *protogen.Method) string {
+	if isEmpty(method.Output) {
+		return "error"
+	}
+	return fmt.Sprintf("(*%s, error)", g.QualifiedGoIdent(method.Output.GoIdent))
+}
+
+// getOutput generates the code that waits for the given future, and returns
+// its output (unless it's Empty) and error.
+func getOutput(g *protogen.GeneratedFile, method *protogen.Method, future string) {
+	i := workflowInstrumentation(g, method)
+	switch {
+	case isEmpty(method.Output) && !i.enabled():
+		g.P("return ", future, ".Get(ctx, nil)")
+	case isEmpty(method.Output):
+		g.P("err := ", future, ".Get(ctx, nil)")
+		i.end(g, "err")
+		g.P("return err")
+	default:
+		g.P("var out *", g.QualifiedGoIdent(method.Output.GoIdent))
+		g.P("err := ", future, ".Get(ctx, &out)")
+		i.end(g, "err")
+		g.P("return out, err")
+	}
+}
@@ -0,0 +1,218 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	DefaultFilenameSuffix = "_temporal.pb.go"
+)
+
+// NamingStyle determines the names of generated helper methods.
+type NamingStyle string
+
+const (
+	// NamingLong is the default style: helper method names include the
+	// service name, e.g. "StartWorkflowFooBar" for method "Bar" in service
+	// "Foo". This prevents conflicts between different services.
+	NamingLong NamingStyle = "long"
+
+	// NamingShort omits the service name from helper method names, e.g.
+	// "StartWorkflowBar" for method "Bar" in service "Foo". This is useful
+	// when each Go package contains a single service.
+	NamingShort NamingStyle = "short"
+)
+
+// Config controls the code generation, based on plugin parameters which are
+// passed to protoc with "--temporal-go_opt=<name>=<value>".
+type Config struct {
+	// Worker enables generating worker functions ("worker" parameter).
+	Worker bool
+	// Client enables generating workflow helpers for clients ("client").
+	Client bool
+	// ChildWorkflows enables generating child workflow helpers ("child").
+	ChildWorkflows bool
+	// Activities enables generating activity helpers ("activity").
+	Activities bool
+	// LocalActivities enables generating local activity helpers ("local").
+	LocalActivities bool
+
+	// FilenameSuffix is appended to the names of generated files ("suffix").
+	FilenameSuffix string
+	// VersionHeader enables mentioning the versions of the plugin and of
+	// protoc in the header of generated files ("version_header").
+	VersionHeader bool
+	// Naming determines the names of generated helper methods ("naming").
+	Naming NamingStyle
+	// Docs enables generating Markdown documentation files ("docs").
+	Docs bool
+	// Manifest enables generating a JSON manifest per file ("manifest").
+	Manifest bool
+	// ManifestAggregate is the name of a JSON manifest of all the files in
+	// a protoc invocation, or empty to skip it ("manifest_aggregate").
+	ManifestAggregate string
+	// Diagram determines the format of topology diagrams per file, if any
+	// ("diagram").
+	Diagram DiagramFormat
+	// DiagramAggregate is the name of a topology diagram of all the files in
+	// a protoc invocation, or empty to skip it ("diagram_aggregate"). Its
+	// extension determines its format, see [Config.DiagramAggregateFormat].
+	DiagramAggregate string
+	// CLI enables generating a command-line tool per service, to start and
+	// inspect its workflows ("cli"). It requires the client helpers.
+	CLI bool
+	// GRPC enables generating a gRPC server per service, which runs its
+	// workflows ("grpc"). It requires the client helpers.
+	GRPC bool
+}
+
+// NewConfig returns a configuration with default values: all the helpers
+// are enabled, with the default filename suffix and naming style.
+func NewConfig() *Config {
+	return &Config{
+		Worker:          true,
+		Client:          true,
+		ChildWorkflows:  true,
+		Activities:      true,
+		LocalActivities: true,
+		FilenameSuffix:  DefaultFilenameSuffix,
+		VersionHeader:   true,
+		Naming:          NamingLong,
+	}
+}
+
+// Set parses a single plugin parameter. It conforms to the "ParamFunc" field
+// in [google.golang.org/protobuf/compiler/protogen.Options], which doesn't
+// call it with the parameters that protogen handles itself (e.g. "paths").
+func (c *Config) Set(name, value string) error {
+	var err error
+	switch name {
+	case "worker":
+		c.Worker, err = parseBool(name, value)
+	case "client":
+		c.Client, err = parseBool(name, value)
+	case "child":
+		c.ChildWorkflows, err = parseBool(name, value)
+	case "activity":
+		c.Activities, err = parseBool(name, value)
+	case "local":
+		c.LocalActivities, err = parseBool(name, value)
+	case "suffix":
+		if !strings.HasSuffix(value, ".go") {
+			return fmt.Errorf(`invalid value for parameter %q: %q doesn't end with ".go"`, name, value)
+		}
+		c.FilenameSuffix = value
+	case "version_header":
+		c.VersionHeader, err = parseBool(name, value)
+	case "docs":
+		c.Docs, err = parseBool(name, value)
+	case "manifest":
+		c.Manifest, err = parseBool(name, value)
+	case "manifest_aggregate":
+		if !strings.HasSuffix(value, ".json") {
+			return fmt.Errorf(`invalid value for parameter %q: %q doesn't end with ".json"`, name, value)
+		}
+		c.ManifestAggregate = value
+	case "diagram":
+		switch DiagramFormat(value) {
+		case DiagramMermaid, DiagramDOT:
+			c.Diagram = DiagramFormat(value)
+		default:
+			return fmt.Errorf("invalid value for parameter %q: %q (want %q or %q)", name, value, DiagramMermaid, DiagramDOT)
+		}
+	case "diagram_aggregate":
+		if !strings.HasSuffix(value, ".mmd") && !strings.HasSuffix(value, ".dot") {
+			return fmt.Errorf(`invalid value for parameter %q: %q doesn't end with ".mmd" or ".dot"`, name, value)
+		}
+		c.DiagramAggregate = value
+	case "cli":
+		c.CLI, err = parseBool(name, value)
+	case "grpc":
+		c.GRPC, err = parseBool(name, value)
+	case "naming":
+		switch NamingStyle(value) {
+		case NamingLong, NamingShort:
+			c.Naming = NamingStyle(value)
+		default:
+			return fmt.Errorf("invalid value for parameter %q: %q (want %q or %q)", name, value, NamingLong, NamingShort)
+		}
+	default:
+		return fmt.Errorf("unknown parameter %q", name)
+	}
+	return err
+}
+
+// Validate reports combinations of parameters which are invalid regardless
+// of their order, after [Config.Set] parses all of them.
+func (c *Config) Validate() error {
+	if c.CLI && !c.Client {
+		return errors.New(`parameter "cli" requires parameter "client"`)
+	}
+	if c.GRPC && !c.Client {
+		return errors.New(`parameter "grpc" requires parameter "client"`)
+	}
+	return nil
+}
+
+// parseBool also accepts an empty value, as a shorthand for "true".
+func parseBool(name, value string) (bool, error) {
+	if value == "" {
+		return true, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid value for parameter %q: %q is not a boolean", name, value)
+	}
+	return b, nil
+}
+
+// helperPrefix returns the service name to embed in the names of generated
+// helper methods, according to the configured naming style.
+func (c *Config) helperPrefix(serviceName string) string {
+	if c.Naming == NamingShort {
+		return ""
+	}
+	return serviceName
+}
+
+// DiagramAggregateFormat returns the format of the aggregate topology
+// diagram, based on the extension of its name.
+func (c *Config) DiagramAggregateFormat() DiagramFormat {
+	if strings.HasSuffix(c.DiagramAggregate, ".dot") {
+		return DiagramDOT
+	}
+	return DiagramMermaid
+}
+
+// anyClientHelpers reports whether any of the helper families that
+// [GenerateClient] generates is enabled.
+func (c *Config) anyClientHelpers() bool {
+	return c.Client || c.ChildWorkflows || c.Activities || c.LocalActivities
+}
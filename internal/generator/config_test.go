@@ -0,0 +1,192 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfigSet(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  [][2]string
+		want    func(c *Config)
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+			want: func(c *Config) {},
+		},
+		{
+			name:   "disable_helper_families",
+			params: [][2]string{{"worker", "false"}, {"client", "0"}, {"child", "false"}, {"activity", "f"}, {"local", "false"}},
+			want: func(c *Config) {
+				c.Worker = false
+				c.Client = false
+				c.ChildWorkflows = false
+				c.Activities = false
+				c.LocalActivities = false
+			},
+		},
+		{
+			name:   "empty_boolean_is_true",
+			params: [][2]string{{"worker", "false"}, {"worker", ""}},
+			want:   func(c *Config) {},
+		},
+		{
+			name:    "invalid_boolean",
+			params:  [][2]string{{"client", "maybe"}},
+			wantErr: true,
+		},
+		{
+			name:   "suffix",
+			params: [][2]string{{"suffix", ".temporal.go"}},
+			want: func(c *Config) {
+				c.FilenameSuffix = ".temporal.go"
+			},
+		},
+		{
+			name:    "invalid_suffix",
+			params:  [][2]string{{"suffix", "_temporal.pb"}},
+			wantErr: true,
+		},
+		{
+			name:   "no_version_header",
+			params: [][2]string{{"version_header", "false"}},
+			want: func(c *Config) {
+				c.VersionHeader = false
+			},
+		},
+		{
+			name:   "docs",
+			params: [][2]string{{"docs", "true"}},
+			want: func(c *Config) {
+				c.Docs = true
+			},
+		},
+		{
+			name:   "manifests",
+			params: [][2]string{{"manifest", ""}, {"manifest_aggregate", "temporal.json"}},
+			want: func(c *Config) {
+				c.Manifest = true
+				c.ManifestAggregate = "temporal.json"
+			},
+		},
+		{
+			name:    "invalid_manifest_aggregate",
+			params:  [][2]string{{"manifest_aggregate", "temporal.yaml"}},
+			wantErr: true,
+		},
+		{
+			name:   "diagrams",
+			params: [][2]string{{"diagram", "dot"}, {"diagram_aggregate", "topology.mmd"}},
+			want: func(c *Config) {
+				c.Diagram = DiagramDOT
+				c.DiagramAggregate = "topology.mmd"
+			},
+		},
+		{
+			name:    "invalid_diagram",
+			params:  [][2]string{{"diagram", "svg"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid_diagram_aggregate",
+			params:  [][2]string{{"diagram_aggregate", "topology.svg"}},
+			wantErr: true,
+		},
+		{
+			name:   "cli",
+			params: [][2]string{{"cli", ""}},
+			want: func(c *Config) {
+				c.CLI = true
+			},
+		},
+		{
+			name:    "cli_without_client",
+			params:  [][2]string{{"cli", "true"}, {"client", "false"}},
+			wantErr: true,
+		},
+		{
+			name:   "grpc",
+			params: [][2]string{{"grpc", "true"}},
+			want: func(c *Config) {
+				c.GRPC = true
+			},
+		},
+		{
+			name:    "grpc_without_client",
+			params:  [][2]string{{"client", "false"}, {"grpc", ""}},
+			wantErr: true,
+		},
+		{
+			name:   "short_naming",
+			params: [][2]string{{"naming", "short"}},
+			want: func(c *Config) {
+				c.Naming = NamingShort
+			},
+		},
+		{
+			name:    "invalid_naming",
+			params:  [][2]string{{"naming", "camel"}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown_parameter",
+			params:  [][2]string{{"foo", "bar"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewConfig()
+			var err error
+			for _, p := range tt.params {
+				if err = got.Set(p[0], p[1]); err != nil {
+					break
+				}
+			}
+			if err == nil {
+				err = got.Validate()
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Set() and Validate() error = nil, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Set() or Validate() error = %v", err)
+			}
+			want := NewConfig()
+			tt.want(want)
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("config mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+// GenerateContinueAsNew generates typed Continue-As-New helpers for all the
+// workflows in the given service.
+func GenerateContinueAsNew(g *protogen.GeneratedFile, service *protogen.Service, cfg *Config) {
+	prefix := cfg.helperPrefix(service.GoName)
+	for _, method := range service.Methods {
+		if isWorkflow(method) {
+			continueAsNew(g, method, prefix)
+		}
+	}
+}
+
+func continueAsNew(g *protogen.GeneratedFile, method *protogen.Method, serviceName string) {
+	w := proto.GetExtension(method.Desc.Options(), workerpb.E_Workflow).(*workerpb.Workflow)
+	o := workflowOptions(method)
+
+	name := "ContinueAsNew" + serviceName + method.GoName
+	g.P("// ", name, " returns an error which ends the current run of the ", method.GoName)
+	g.P("// workflow, and starts a new run with the same workflow ID, the given input,")
+	g.P("// and the options in its proto definition. The workflow should return it as is.")
+	if n := w.GetContinueAsNewHistoryLength(); n > 0 {
+		g.P("// It returns nil (i.e. the workflow should keep running) unless the workflow's")
+		g.P("// history has at least ", n, " events.")
+	}
+	g.P("// For more information, see https://docs.temporal.io/workflows#continue-as-new.")
+	g.P("func ", name, "(ctx ", workflowPackage.Ident("Context"), inputParam(g, method), ") error {")
+	if n := w.GetContinueAsNewHistoryLength(); n > 0 {
+		g.P("if ", workflowPackage.Ident("GetInfo"), "(ctx).GetCurrentHistoryLength() < ", n, " {")
+		g.P("return nil")
+		g.P("}")
+	}
+	if tq := taskQueue(method, o.TaskQueue); tq != nil && *tq != "" {
+		g.P("ctx = ", workflowPackage.Ident("WithWorkflowTaskQueue"), "(ctx, ", strconv.Quote(*tq), ")")
+	}
+	if o.WorkflowRunTimeout != nil {
+		g.P("ctx = ", workflowPackage.Ident("WithWorkflowRunTimeout"), "(ctx, ", durationValue(g, o.WorkflowRunTimeout.AsDuration()), ")")
+	}
+	if o.WorkflowTaskTimeout != nil {
+		g.P("ctx = ", workflowPackage.Ident("WithWorkflowTaskTimeout"), "(ctx, ", durationValue(g, o.WorkflowTaskTimeout.AsDuration()), ")")
+	}
+	g.P("return ", workflowPackage.Ident("NewContinueAsNewError"), "(ctx, ", strconv.Quote(method.GoName), inputArg(method), ")")
+	g.P("}")
+	g.P()
+}
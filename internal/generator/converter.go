@@ -0,0 +1,203 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+// GenerateDataConverter generates a data converter for the given service, and
+// a function which checks the compatibility of other data converters with it,
+// if the service specifies a payload encoding. It also generates a data
+// converter which encrypts sensitive fields, if the service's messages have
+// any, a wrapper which offloads large payloads, if the service specifies a
+// threshold, and a function which creates clients with all of them.
+func GenerateDataConverter(g *protogen.GeneratedFile, service *protogen.Service) {
+	w := proto.GetExtension(service.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker)
+	encodedDataConverter(g, service, w.GetPayloadEncoding())
+	if usesSensitiveFields(service) {
+		sensitiveDataConverter(g, service, w.GetPayloadEncoding())
+	}
+	if w.GetLargePayloadThreshold() > 0 {
+		largePayloadDataConverter(g, service, w.LargePayloadThreshold)
+	}
+	dial(g, service, w)
+}
+
+func encodedDataConverter(g *protogen.GeneratedFile, service *protogen.Service, e workerpb.PayloadEncoding) {
+	var encoding, description string
+	switch e {
+	case workerpb.PayloadEncoding_PAYLOAD_ENCODING_BINARY:
+		encoding, description = "MetadataEncodingProto", "binary"
+	case workerpb.PayloadEncoding_PAYLOAD_ENCODING_JSON:
+		encoding, description = "MetadataEncodingProtoJSON", "JSON"
+	default:
+		return
+	}
+	first, second := protoPayloadConverters(e)
+
+	dataConverter := service.GoName + "DataConverter"
+	g.P("// ", dataConverter, " returns the data converter of ", service.GoName, ", which encodes")
+	g.P("// proto messages as ", description, " (as specified in its proto definition), and other")
+	g.P("// values like the SDK's default data converter. It can still decode proto")
+	g.P("// messages in both encodings.")
+	g.P("func ", dataConverter, "() ", converterPackage.Ident("DataConverter"), " {")
+	g.P("return ", converterPackage.Ident("NewCompositeDataConverter"), "(")
+	g.P(converterPackage.Ident("NewNilPayloadConverter"), "(),")
+	g.P(converterPackage.Ident("NewByteSlicePayloadConverter"), "(),")
+	g.P(first, "(),")
+	g.P(second, "(),")
+	g.P(converterPackage.Ident("NewJSONPayloadConverter"), "(),")
+	g.P(")")
+	g.P("}")
+	g.P()
+
+	check := "Check" + dataConverter
+	g.P("// ", check, " returns an error if the given data converter doesn't encode")
+	g.P("// proto messages as ", description, ", like ", dataConverter, ".")
+	g.P("func ", check, "(dc ", converterPackage.Ident("DataConverter"), ") error {")
+	g.P("p, err := dc.ToPayload(&", emptypbPackage.Ident("Empty"), "{})")
+	g.P("if err != nil {")
+	g.P("return ", fmtPackage.Ident("Errorf"), `("incompatible data converter for `, service.GoName, `: %w", err)`)
+	g.P("}")
+	g.P("if e := string(p.Metadata[", converterPackage.Ident("MetadataEncoding"), "]); e != ", converterPackage.Ident(encoding), " {")
+	g.P("return ", fmtPackage.Ident("Errorf"), `("incompatible data converter for `, service.GoName, `: proto messages are encoded as %q instead of %q", e, `, converterPackage.Ident(encoding), ")")
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+}
+
+// dial generates a function which creates clients with the data converters of
+// the given service, if it has any (see [GenerateDataConverter]).
+func dial(g *protogen.GeneratedFile, service *protogen.Service, w *workerpb.Worker) {
+	sensitive := usesSensitiveFields(service)
+	large := w.GetLargePayloadThreshold() > 0
+	if !hasPayloadEncoding(service) && !sensitive && !large {
+		return
+	}
+
+	name := service.GoName
+	var base string
+	switch {
+	case sensitive:
+		base = name + "SensitiveDataConverter(kp)"
+	case hasPayloadEncoding(service):
+		base = name + "DataConverter()"
+	default:
+		base = g.QualifiedGoIdent(converterPackage.Ident("GetDefaultDataConverter")) + "()"
+	}
+	params := "opts " + g.QualifiedGoIdent(clientPackage.Ident("Options"))
+	if sensitive {
+		params += ", kp " + g.QualifiedGoIdent(sensitivePackage.Ident("KeyProvider"))
+	}
+	if large {
+		params += ", store " + g.QualifiedGoIdent(claimcheckPackage.Ident("BlobStore"))
+	}
+
+	g.P("// Dial", name, " creates a client with the data converter of ", name, " if the")
+	if large {
+		g.P("// given options don't specify a data converter: ", base, ",")
+		g.P("// wrapped by ", name, "LargePayloadDataConverter with the given blob store.")
+	} else {
+		g.P("// given options don't specify a data converter: ", base, ".")
+	}
+	if hasPayloadEncoding(service) {
+		g.P("// It returns an error if they specify a data converter with a different")
+		g.P("// encoding, instead of failing later in workflows and activities.")
+	}
+	g.P("// Use it instead of client.Dial to create the client which is passed to the")
+	g.P("// other generated functions of ", name, ".")
+	g.P("func Dial", name, "(", params, ") (", clientPackage.Ident("Client"), ", error) {")
+	g.P("if opts.DataConverter == nil {")
+	if large {
+		g.P("opts.DataConverter = ", name, "LargePayloadDataConverter(", base, ", store)")
+	} else {
+		g.P("opts.DataConverter = ", base)
+	}
+	if hasPayloadEncoding(service) {
+		g.P("} else if err := Check", name, "DataConverter(opts.DataConverter); err != nil {")
+		g.P("return nil, err")
+	}
+	g.P("}")
+	g.P("return ", clientPackage.Ident("Dial"), "(opts)")
+	g.P("}")
+	g.P()
+}
+
+// hasPayloadEncoding reports whether the given service specifies a payload
+// encoding, i.e. whether it has a Check<Service>DataConverter function.
+func hasPayloadEncoding(service *protogen.Service) bool {
+	w := proto.GetExtension(service.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker)
+	return w.GetPayloadEncoding() != workerpb.PayloadEncoding_PAYLOAD_ENCODING_UNSPECIFIED
+}
+
+// protoPayloadConverters returns the constructors of the SDK's payload
+// converters for proto messages, in order of precedence: the first one
+// determines the encoding of proto messages, as in the SDK's default data
+// converter, and the second one can still decode the other encoding.
+func protoPayloadConverters(e workerpb.PayloadEncoding) (first, second protogen.GoIdent) {
+	if e == workerpb.PayloadEncoding_PAYLOAD_ENCODING_BINARY {
+		return converterPackage.Ident("NewProtoPayloadConverter"), converterPackage.Ident("NewProtoJSONPayloadConverter")
+	}
+	return converterPackage.Ident("NewProtoJSONPayloadConverter"), converterPackage.Ident("NewProtoPayloadConverter")
+}
+
+func sensitiveDataConverter(g *protogen.GeneratedFile, service *protogen.Service, e workerpb.PayloadEncoding) {
+	first, second := protoPayloadConverters(e)
+	name := service.GoName + "SensitiveDataConverter"
+	g.P("// ", name, " returns a data converter for ", service.GoName, " which encrypts")
+	g.P("// the sensitive fields of proto messages before they leave the client or worker,")
+	g.P("// and decrypts them on the way in, with keys from the given key provider.")
+	g.P("func ", name, "(kp ", sensitivePackage.Ident("KeyProvider"), ") ", converterPackage.Ident("DataConverter"), " {")
+	g.P("return ", converterPackage.Ident("NewCompositeDataConverter"), "(")
+	g.P(converterPackage.Ident("NewNilPayloadConverter"), "(),")
+	g.P(converterPackage.Ident("NewByteSlicePayloadConverter"), "(),")
+	g.P(sensitivePackage.Ident("NewPayloadConverter"), "(", first, "(), kp),")
+	g.P(sensitivePackage.Ident("NewPayloadConverter"), "(", second, "(), kp),")
+	g.P(converterPackage.Ident("NewJSONPayloadConverter"), "(),")
+	g.P(")")
+	g.P("}")
+	g.P()
+}
+
+func largePayloadDataConverter(g *protogen.GeneratedFile, service *protogen.Service, threshold int64) {
+	constName := service.GoName + "LargePayloadThreshold"
+	g.P("// ", constName, " is the size threshold (in bytes) of large payloads of ", service.GoName, ".")
+	g.P("const ", constName, " = ", threshold)
+	g.P()
+
+	name := service.GoName + "LargePayloadDataConverter"
+	g.P("// ", name, " wraps the given data converter, so it stores payloads")
+	g.P("// which are larger than ", constName, " in the given blob store, and")
+	g.P("// replaces them with references. Use it in both clients and workers of ", service.GoName, ".")
+	g.P("func ", name, "(dc ", converterPackage.Ident("DataConverter"), ", store ", claimcheckPackage.Ident("BlobStore"), ") ", converterPackage.Ident("DataConverter"), " {")
+	g.P("return ", converterPackage.Ident("NewCodecDataConverter"), "(dc, ", claimcheckPackage.Ident("NewCodec"), "(store, ", constName, "))")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,100 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+// activityOptions returns the effective options of an activity method, based
+// on the default options of its file and service, and its own options.
+func activityOptions(method *protogen.Method) *workerpb.ActivityOptions {
+	f := proto.GetExtension(method.Desc.ParentFile().Options(), workerpb.E_File).(*workerpb.File)
+	w := proto.GetExtension(method.Parent.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker)
+	a := proto.GetExtension(method.Desc.Options(), workerpb.E_Activity).(*workerpb.Activity)
+	return mergeOptions(f.GetDefaultActivityOptions(), w.GetDefaultActivityOptions(), a.GetOptions())
+}
+
+// workflowOptions returns the effective options of a workflow method, based
+// on the default options of its file and service, and its own options.
+func workflowOptions(method *protogen.Method) *workerpb.StartWorkflowOptions {
+	f := proto.GetExtension(method.Desc.ParentFile().Options(), workerpb.E_File).(*workerpb.File)
+	w := proto.GetExtension(method.Parent.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker)
+	wf := proto.GetExtension(method.Desc.Options(), workerpb.E_Workflow).(*workerpb.Workflow)
+	return mergeOptions(f.GetDefaultWorkflowOptions(), w.GetDefaultWorkflowOptions(), wf.GetOptions())
+}
+
+// effectiveOptions returns the effective options of a method (see
+// [workflowOptions] and [activityOptions]), including its task queue.
+func effectiveOptions(method *protogen.Method) proto.Message {
+	if isWorkflow(method) {
+		o := workflowOptions(method)
+		o.TaskQueue = taskQueue(method, o.TaskQueue)
+		return o
+	}
+	o := activityOptions(method)
+	o.TaskQueue = taskQueue(method, o.TaskQueue)
+	return o
+}
+
+// taskQueue returns the task queue to use when scheduling a method: the one
+// in its explicit (or default) options, if there is one, or else the task
+// queue of the worker of the service which owns the method. This ensures that
+// workflows and activities are scheduled where they are actually registered,
+// instead of in the task queue of the caller (clients don't have one).
+func taskQueue(method *protogen.Method, explicit *string) *string {
+	if explicit != nil {
+		return explicit
+	}
+	w := proto.GetExtension(method.Parent.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker)
+	if w.GetTaskQueue() == "" {
+		return nil
+	}
+	return proto.String(w.GetTaskQueue())
+}
+
+// mergeOptions merges the given options, from the least specific to the most
+// specific level (nil levels are ignored): each field which is present in a
+// level replaces the same field in all the previous levels. Message fields are
+// replaced as a whole, not merged. The result is never nil.
+func mergeOptions[T proto.Message](levels ...T) T {
+	var zero T
+	merged := zero.ProtoReflect().New()
+	for _, level := range levels {
+		m := level.ProtoReflect()
+		if !m.IsValid() {
+			continue
+		}
+		m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			merged.Set(fd, v)
+			return true
+		})
+	}
+	return merged.Interface().(T)
+}
@@ -0,0 +1,117 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"testing"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+func TestMergeOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		levels []*workerpb.ActivityOptions
+		want   *workerpb.ActivityOptions
+	}{
+		{
+			name: "no_levels",
+			want: &workerpb.ActivityOptions{},
+		},
+		{
+			name:   "nil_levels",
+			levels: []*workerpb.ActivityOptions{nil, nil, nil},
+			want:   &workerpb.ActivityOptions{},
+		},
+		{
+			name: "inherit_from_less_specific_levels",
+			levels: []*workerpb.ActivityOptions{
+				{TaskQueue: proto.String("file")},
+				nil,
+				{HeartbeatTimeout: durationpb.New(1)},
+			},
+			want: &workerpb.ActivityOptions{
+				TaskQueue:        proto.String("file"),
+				HeartbeatTimeout: durationpb.New(1),
+			},
+		},
+		{
+			name: "more_specific_levels_override",
+			levels: []*workerpb.ActivityOptions{
+				{TaskQueue: proto.String("file"), HeartbeatTimeout: durationpb.New(1)},
+				{TaskQueue: proto.String("service")},
+				{HeartbeatTimeout: durationpb.New(2)},
+			},
+			want: &workerpb.ActivityOptions{
+				TaskQueue:        proto.String("service"),
+				HeartbeatTimeout: durationpb.New(2),
+			},
+		},
+		{
+			name: "present_zero_values_override",
+			levels: []*workerpb.ActivityOptions{
+				{WaitForCancellation: proto.Bool(true), TaskQueue: proto.String("file")},
+				{WaitForCancellation: proto.Bool(false)},
+				{TaskQueue: proto.String("")},
+			},
+			want: &workerpb.ActivityOptions{
+				WaitForCancellation: proto.Bool(false),
+				TaskQueue:           proto.String(""),
+			},
+		},
+		{
+			name: "messages_are_replaced_not_merged",
+			levels: []*workerpb.ActivityOptions{
+				{Retry: &workerpb.ActivityOptions_RetryPolicy{RetryPolicy: &commonpb.RetryPolicy{MaximumAttempts: 3, NonRetryableErrorTypes: []string{"Foo"}}}},
+				{Retry: &workerpb.ActivityOptions_RetryPolicy{RetryPolicy: &commonpb.RetryPolicy{BackoffCoefficient: 1.5}}},
+			},
+			want: &workerpb.ActivityOptions{
+				Retry: &workerpb.ActivityOptions_RetryPolicy{RetryPolicy: &commonpb.RetryPolicy{BackoffCoefficient: 1.5}},
+			},
+		},
+		{
+			name: "oneof_members_replace_each_other",
+			levels: []*workerpb.ActivityOptions{
+				{Retry: &workerpb.ActivityOptions_RetryPolicyRef{RetryPolicyRef: "foo"}},
+				{Retry: &workerpb.ActivityOptions_RetryPolicy{RetryPolicy: &commonpb.RetryPolicy{MaximumAttempts: 1}}},
+			},
+			want: &workerpb.ActivityOptions{
+				Retry: &workerpb.ActivityOptions_RetryPolicy{RetryPolicy: &commonpb.RetryPolicy{MaximumAttempts: 1}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeOptions(tt.levels...)
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("mergeOptions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
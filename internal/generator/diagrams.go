@@ -0,0 +1,278 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+// DiagramFormat determines the format of generated topology diagrams.
+type DiagramFormat string
+
+const (
+	// DiagramNone disables topology diagrams (the default).
+	DiagramNone DiagramFormat = ""
+	// DiagramMermaid generates Mermaid flowcharts (https://mermaid.js.org/).
+	DiagramMermaid DiagramFormat = "mermaid"
+	// DiagramDOT generates Graphviz graphs (https://graphviz.org/).
+	DiagramDOT DiagramFormat = "dot"
+)
+
+// FilenameSuffix returns the suffix of the names of generated diagrams.
+func (f DiagramFormat) FilenameSuffix() string {
+	if f == DiagramDOT {
+		return "_temporal.dot"
+	}
+	return "_temporal.mmd"
+}
+
+// calls returns the activities and child workflows which the given method
+// declares that it calls, if it's a workflow.
+func (idx *Index) calls(method *protogen.Method) ([]*protogen.Method, error) {
+	w := proto.GetExtension(method.Desc.Options(), workerpb.E_Workflow).(*workerpb.Workflow)
+	path := method.Desc.ParentFile().Path()
+
+	visible := map[string]bool{path: true}
+	if f := idx.file(method.Desc); f != nil {
+		for _, imported := range idx.imports(f) {
+			visible[imported.Desc.Path()] = true
+		}
+	}
+
+	var result []*protogen.Method
+	seen := map[*protogen.Method]bool{}
+	for _, name := range w.GetCalls() {
+		callee := idx.method(method.Desc.ParentFile(), name)
+		if callee == nil || !visible[callee.Desc.ParentFile().Path()] {
+			return nil, fmt.Errorf("%s: workflow %s calls undefined workflow or activity %q",
+				path, method.Desc.FullName(), name)
+		}
+		if seen[callee] {
+			return nil, fmt.Errorf("%s: workflow %s calls %s more than once",
+				path, method.Desc.FullName(), callee.Desc.FullName())
+		}
+		seen[callee] = true
+		result = append(result, callee)
+	}
+	return result, nil
+}
+
+// GenerateDiagram generates a topology diagram of the services in the given
+// files: their task queues, workflows and activities, the task queues which
+// methods are routed to (if different from their service's task queue), and
+// the activities and child workflows which workflows call.
+func GenerateDiagram(g *protogen.GeneratedFile, files []*protogen.File, format DiagramFormat, idx *Index) {
+	d := &diagram{format: format, nodes: map[string]bool{}}
+	d.begin(g)
+
+	var (
+		edges   []diagramEdge
+		callees []*protogen.Method
+	)
+	for _, f := range files {
+		for _, service := range f.Services {
+			d.service(g, service)
+			for _, method := range service.Methods {
+				if tq := methodTaskQueue(method); tq != nil && *tq != "" && *tq != serviceTaskQueue(service) {
+					edges = append(edges, diagramEdge{from: diagramMethodID(method), to: d.taskQueueID(*tq), label: "routed", dashed: true})
+				}
+				calls, _ := idx.calls(method)
+				for _, callee := range calls {
+					label := "activity"
+					if isWorkflow(callee) {
+						label = "child workflow"
+					}
+					edges = append(edges, diagramEdge{from: diagramMethodID(method), to: diagramMethodID(callee), label: label})
+					callees = append(callees, callee)
+				}
+			}
+		}
+	}
+
+	// Nodes which are outside of the given files' services: callees in other
+	// files, and task queues.
+	for _, callee := range callees {
+		if d.nodes[diagramMethodID(callee)] {
+			continue
+		}
+		label := string(callee.Desc.FullName())
+		if tq := methodTaskQueue(callee); tq != nil && *tq != "" {
+			label += "\ntask queue: " + *tq
+		}
+		d.node(g, "  ", callee, label)
+	}
+	var queues []string
+	for tq := range d.queues {
+		queues = append(queues, tq)
+	}
+	sort.Strings(queues)
+	for _, tq := range queues {
+		d.taskQueue(g, tq)
+	}
+
+	for _, e := range edges {
+		d.edge(g, e)
+	}
+	d.end(g)
+}
+
+type diagram struct {
+	format DiagramFormat
+	nodes  map[string]bool
+	queues map[string]bool
+}
+
+type diagramEdge struct {
+	from, to, label string
+	dashed          bool
+}
+
+var diagramIDRegexp = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// diagramID converts the given name to an ID which is valid in both formats.
+func diagramID(prefix, name string) string {
+	return prefix + "_" + diagramIDRegexp.ReplaceAllString(name, "_")
+}
+
+func diagramMethodID(method *protogen.Method) string {
+	return diagramID("m", string(method.Desc.FullName()))
+}
+
+func (d *diagram) taskQueueID(tq string) string {
+	if d.queues == nil {
+		d.queues = map[string]bool{}
+	}
+	d.queues[tq] = true
+	return diagramID("tq", tq)
+}
+
+func serviceTaskQueue(service *protogen.Service) string {
+	w := proto.GetExtension(service.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker)
+	return w.GetTaskQueue()
+}
+
+func (d *diagram) begin(g *protogen.GeneratedFile) {
+	if d.format == DiagramDOT {
+		g.P("// Code generated by ", Executable, ". DO NOT EDIT.")
+		g.P("digraph temporal {")
+		g.P("  rankdir=LR;")
+		return
+	}
+	g.P("%% Code generated by ", Executable, ". DO NOT EDIT.")
+	g.P("flowchart LR")
+}
+
+func (d *diagram) end(g *protogen.GeneratedFile) {
+	if d.format == DiagramDOT {
+		g.P("}")
+	}
+}
+
+// service generates a subgraph of the given service, labeled with its name
+// and the task queue of its worker, which contains its methods.
+func (d *diagram) service(g *protogen.GeneratedFile, service *protogen.Service) {
+	label := string(service.Desc.FullName())
+	if tq := serviceTaskQueue(service); tq != "" {
+		label += "\ntask queue: " + tq
+	}
+	id := diagramID("s", string(service.Desc.FullName()))
+	if d.format == DiagramDOT {
+		g.P("  subgraph cluster_", id, " {")
+		g.P("    label=", strconv.Quote(label), ";")
+	} else {
+		g.P("  subgraph ", id, "[", mermaidLabel(label), "]")
+	}
+	for _, method := range service.Methods {
+		d.node(g, "    ", method, string(method.Desc.Name()))
+	}
+	if d.format == DiagramDOT {
+		g.P("  }")
+	} else {
+		g.P("  end")
+	}
+}
+
+// node generates a node of a method: workflows have rounded shapes, and
+// activities have rectangular shapes. Methods in the diagram's services are
+// labeled with their names, and other callees with their full names and
+// effective task queues.
+func (d *diagram) node(g *protogen.GeneratedFile, indent string, method *protogen.Method, label string) {
+	id := diagramMethodID(method)
+	d.nodes[id] = true
+	if d.format == DiagramDOT {
+		shape := "box"
+		if isWorkflow(method) {
+			shape = "ellipse"
+		}
+		g.P(indent, id, " [label=", strconv.Quote(label), ", shape=", shape, "];")
+		return
+	}
+	if isWorkflow(method) {
+		g.P(indent, id, "([", mermaidLabel(label), "])")
+	} else {
+		g.P(indent, id, "[", mermaidLabel(label), "]")
+	}
+}
+
+func (d *diagram) taskQueue(g *protogen.GeneratedFile, tq string) {
+	id := diagramID("tq", tq)
+	if d.format == DiagramDOT {
+		g.P("  ", id, " [label=", strconv.Quote("task queue: "+tq), ", shape=cds];")
+		return
+	}
+	g.P("  ", id, "[[", mermaidLabel("task queue: "+tq), "]]")
+}
+
+func (d *diagram) edge(g *protogen.GeneratedFile, e diagramEdge) {
+	if d.format == DiagramDOT {
+		style := ""
+		if e.dashed {
+			style = ", style=dashed"
+		}
+		g.P("  ", e.from, " -> ", e.to, " [label=", strconv.Quote(e.label), style, "];")
+		return
+	}
+	arrow := "-->"
+	if e.dashed {
+		arrow = "-.->"
+	}
+	g.P("  ", e.from, " ", arrow, "|", mermaidLabel(e.label), "| ", e.to)
+}
+
+// mermaidLabel quotes a label for Mermaid, which supports "<br>" line breaks
+// and HTML entities, but not backslash escapes.
+func mermaidLabel(s string) string {
+	s = strings.ReplaceAll(s, `"`, "#quot;")
+	return `"` + strings.ReplaceAll(s, "\n", "<br>") + `"`
+}
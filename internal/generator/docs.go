@@ -0,0 +1,233 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+const (
+	DocsFilenameSuffix = "_temporal.md"
+)
+
+// GenerateDocs generates Markdown documentation of the workers, workflows
+// and activities in the given file, with their effective options and the
+// names of their generated Go helpers.
+func GenerateDocs(g *protogen.GeneratedFile, f *protogen.File, cfg *Config, idx *Index) {
+	g.P("<!-- Code generated by ", Executable, ". DO NOT EDIT. -->")
+	g.P()
+	g.P("# Temporal Workers: ", f.Desc.Path())
+	for _, service := range f.Services {
+		g.P()
+		serviceDocs(g, service, cfg)
+		for _, method := range service.Methods {
+			g.P()
+			methodDocs(g, method, cfg, idx)
+		}
+	}
+}
+
+func serviceDocs(g *protogen.GeneratedFile, service *protogen.Service, cfg *Config) {
+	g.P("## ", service.Desc.FullName())
+	g.P()
+	docsComments(g, service.Comments.Leading)
+	if service.Desc.Options().(*descriptorpb.ServiceOptions).GetDeprecated() {
+		g.P("**Deprecated.**")
+		g.P()
+	}
+
+	w := proto.GetExtension(service.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker)
+	if w.GetTaskQueue() == "" {
+		g.P("- Task queue: none (no worker)")
+	} else {
+		g.P("- Task queue: `", w.GetTaskQueue(), "`")
+	}
+	if lines := docsOptions(w.GetOptions()); len(lines) > 0 {
+		g.P("- Worker options:")
+		for _, line := range lines {
+			g.P("  - ", line)
+		}
+	}
+	if cfg.Worker && w.GetTaskQueue() != "" {
+		g.P("- Go helpers: ", docsHelpers(
+			"NewWorker"+service.GoName, "Register"+service.GoName, "StartWorker"+service.GoName))
+	}
+}
+
+func methodDocs(g *protogen.GeneratedFile, method *protogen.Method, cfg *Config, idx *Index) {
+	var (
+		kind    string
+		helpers []string
+	)
+	prefix := cfg.helperPrefix(method.Parent.GoName)
+	opts := effectiveOptions(method)
+	if isWorkflow(method) {
+		kind = "Workflow"
+		if cfg.Client {
+			helpers = append(helpers, "StartWorkflow", "ExecuteWorkflow")
+		}
+		if cfg.ChildWorkflows {
+			helpers = append(helpers, "StartChildWorkflow", "ExecuteChildWorkflow")
+		}
+		helpers = append(helpers, "ContinueAsNew")
+	} else {
+		kind = "Activity"
+		if cfg.Activities {
+			helpers = append(helpers, "StartActivity", "ExecuteActivity")
+		}
+		if cfg.LocalActivities {
+			helpers = append(helpers, "StartLocalActivity", "ExecuteLocalActivity")
+		}
+		if details, _ := idx.heartbeatDetails(method); details != nil {
+			helpers = append(helpers, "RecordHeartbeat", "GetHeartbeat")
+		}
+	}
+	for i, h := range helpers {
+		helpers[i] = h + prefix + method.GoName
+	}
+
+	g.P("### ", kind, " `", method.Desc.Name(), "`")
+	g.P()
+	docsComments(g, method.Comments.Leading)
+	if method.Desc.Options().(*descriptorpb.MethodOptions).GetDeprecated() {
+		g.P("**Deprecated.**")
+		g.P()
+	}
+	g.P("- Input: `", method.Input.Desc.FullName(), "`")
+	g.P("- Output: `", method.Output.Desc.FullName(), "`")
+	if lines := docsOptions(opts); len(lines) > 0 {
+		g.P("- Options:")
+		for _, line := range lines {
+			g.P("  - ", line)
+		}
+	}
+	if len(helpers) > 0 {
+		g.P("- Go helpers: ", docsHelpers(helpers...))
+	}
+}
+
+// docsComments generates the given proto comments as a Markdown paragraph.
+func docsComments(g *protogen.GeneratedFile, c protogen.Comments) {
+	s := strings.TrimSpace(string(c))
+	if s == "" {
+		return
+	}
+	for _, line := range strings.Split(s, "\n") {
+		g.P(strings.TrimSpace(line))
+	}
+	g.P()
+}
+
+func docsHelpers(names ...string) string {
+	return "`" + strings.Join(names, "`, `") + "`"
+}
+
+// docsOptions returns the non-default fields of the given options, in the
+// order of their definition, formatted as "`name`: value".
+func docsOptions(opts proto.Message) []string {
+	var lines []string
+	docsFields(opts, func(name, value string) {
+		lines = append(lines, fmt.Sprintf("`%s`: %s", name, value))
+	})
+	return lines
+}
+
+// docsFields calls f with the name and formatted value of each non-default
+// field of the given message, in the order of their definition.
+func docsFields(msg proto.Message, f func(name, value string)) {
+	if msg == nil {
+		return
+	}
+	m := msg.ProtoReflect()
+	if !m.IsValid() {
+		return
+	}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if m.Has(fd) {
+			f(string(fd.Name()), docsValue(fd, m.Get(fd)))
+		}
+	}
+}
+
+// docsValue formats a single option value. Durations are formatted as in Go,
+// and other messages recursively, because the output of the prototext
+// package is deliberately unstable.
+func docsValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch {
+	case fd.IsList():
+		l := v.List()
+		items := make([]string, l.Len())
+		for i := range items {
+			items[i] = docsScalar(fd, l.Get(i))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case fd.IsMap():
+		var items []string
+		v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			items = append(items, docsScalar(fd.MapKey(), k.Value())+": "+docsScalar(fd.MapValue(), v))
+			return true
+		})
+		sort.Strings(items)
+		return "{" + strings.Join(items, ", ") + "}"
+	default:
+		return docsScalar(fd, v)
+	}
+}
+
+func docsScalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if d, ok := v.Message().Interface().(*durationpb.Duration); ok {
+			return d.AsDuration().String()
+		}
+		var fields []string
+		docsFields(v.Message().Interface(), func(name, value string) {
+			fields = append(fields, name+": "+value)
+		})
+		return "{" + strings.Join(fields, ", ") + "}"
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return strconv.Itoa(int(v.Enum()))
+	case protoreflect.StringKind:
+		return strconv.Quote(v.String())
+	default:
+		return v.String()
+	}
+}
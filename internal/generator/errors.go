@@ -0,0 +1,150 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+const (
+	errorTypeSuffix = "ErrorType"
+)
+
+// GenerateErrors generates a constant, a constructor and a matcher for each
+// application error type which the methods of the given service declare.
+func GenerateErrors(g *protogen.GeneratedFile, service *protogen.Service, cfg *Config, idx *Index) {
+	prefix := cfg.helperPrefix(service.GoName)
+	for _, e := range serviceErrorTypes(service) {
+		details := idx.message(service.Desc.ParentFile(), e.Details)
+		name := prefix + goCamelCase(e.Type)
+
+		g.P("// ", name+errorTypeSuffix, " is the type of ", service.GoName, " application errors with")
+		g.P("// *", details.GoIdent.GoName, " details.")
+		g.P("const ", name+errorTypeSuffix, " = ", fmt.Sprintf("%q", e.Type))
+		g.P()
+
+		newError(g, e, name, details)
+		asError(g, name, details)
+	}
+}
+
+func newError(g *protogen.GeneratedFile, e *workerpb.ErrorType, name string, details *protogen.Message) {
+	g.P("// New", name, "Error returns an application error with the given details,")
+	g.P("// to return from workflows and activities. Its type is ", name+errorTypeSuffix, ".")
+	if e.NonRetryable {
+		g.P("// Methods which declare this error type don't retry it.")
+	}
+	g.P("func New", name, "Error(message string, cause error, details *", details.GoIdent, ") error {")
+	g.P("return ", temporalPackage.Ident("NewApplicationErrorWithCause"), "(message, ", name+errorTypeSuffix, ", cause, details)")
+	g.P("}")
+	g.P()
+}
+
+func asError(g *protogen.GeneratedFile, name string, details *protogen.Message) {
+	g.P("// As", name, " reports whether the given error (or any error in its")
+	g.P("// chain) is an application error whose type is ", name+errorTypeSuffix, ",")
+	g.P("// and if so returns its details.")
+	g.P("func As", name, "(err error) (*", details.GoIdent, ", bool) {")
+	g.P("var appErr *", temporalPackage.Ident("ApplicationError"))
+	g.P("if !", errorsPackage.Ident("As"), "(err, &appErr) || appErr.Type() != ", name+errorTypeSuffix, " || !appErr.HasDetails() {")
+	g.P("return nil, false")
+	g.P("}")
+	g.P("var details *", details.GoIdent)
+	g.P("if err := appErr.Details(&details); err != nil {")
+	g.P("return nil, false")
+	g.P("}")
+	g.P("return details, true")
+	g.P("}")
+	g.P()
+}
+
+// methodErrorTypes returns the error types which the given method declares.
+func methodErrorTypes(method *protogen.Method) []*workerpb.ErrorType {
+	if isWorkflow(method) {
+		return proto.GetExtension(method.Desc.Options(), workerpb.E_Workflow).(*workerpb.Workflow).GetErrors()
+	}
+	return proto.GetExtension(method.Desc.Options(), workerpb.E_Activity).(*workerpb.Activity).GetErrors()
+}
+
+// serviceErrorTypes returns the error types which the methods of the given
+// service declare, without duplicates, in order of declaration.
+func serviceErrorTypes(service *protogen.Service) []*workerpb.ErrorType {
+	var result []*workerpb.ErrorType
+	seen := map[string]bool{}
+	for _, method := range service.Methods {
+		for _, e := range methodErrorTypes(method) {
+			if !seen[e.Type] {
+				seen[e.Type] = true
+				result = append(result, e)
+			}
+		}
+	}
+	return result
+}
+
+// nonRetryableErrorTypes returns the non-retryable error types which the
+// given method declares.
+func nonRetryableErrorTypes(method *protogen.Method) []string {
+	var result []string
+	for _, e := range methodErrorTypes(method) {
+		if e.NonRetryable {
+			result = append(result, e.Type)
+		}
+	}
+	return result
+}
+
+// validateErrorTypes reports invalid error types in the given method, and
+// error types which conflict with other methods of the same service, or have
+// the same Go name as other error types in it.
+func validateErrorTypes(method *protogen.Method, idx *Index) error {
+	path := method.Desc.ParentFile().Path()
+	for _, e := range methodErrorTypes(method) {
+		if !identifierRegexp.MatchString(e.Type) {
+			return fmt.Errorf("%s: rpc %s declares an invalid error type %q",
+				path, method.Desc.FullName(), e.Type)
+		}
+		if idx.message(method.Desc.ParentFile(), e.Details) == nil {
+			return fmt.Errorf("%s: rpc %s declares error type %q with an undefined details message %q",
+				path, method.Desc.FullName(), e.Type, e.Details)
+		}
+		for _, other := range serviceErrorTypes(method.Parent) {
+			if other.Type == e.Type && !proto.Equal(other, e) {
+				return fmt.Errorf("%s: rpc %s declares error type %q differently than another method in service %s",
+					path, method.Desc.FullName(), e.Type, method.Parent.Desc.FullName())
+			}
+			if other.Type != e.Type && goCamelCase(other.Type) == goCamelCase(e.Type) {
+				return fmt.Errorf("%s: rpc %s declares error type %q, which has the same Go name %q as error type %q in service %s",
+					path, method.Desc.FullName(), e.Type, goCamelCase(e.Type), other.Type, method.Parent.Desc.FullName())
+			}
+		}
+	}
+	return nil
+}
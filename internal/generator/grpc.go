@@ -0,0 +1,180 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// GenerateGRPCServer generates an implementation of the server interface
+// which protoc-gen-go-grpc generates for the given service, if it has any
+// workflows: each workflow rpc runs the workflow with its client helpers,
+// and other rpcs remain unimplemented.
+func GenerateGRPCServer(g *protogen.GeneratedFile, service *protogen.Service, cfg *Config) {
+	if !cfg.GRPC || !hasWorkflows(service) {
+		return
+	}
+
+	name := service.GoName
+	serverName := unexport(name) + "GRPCServer"
+	optionName := name + "GRPCServerOption"
+
+	g.P("const (")
+	g.P("// ", name, "WorkflowIDHeader is the gRPC response header with the ID of the workflow")
+	g.P("// which a ", name, " rpc starts, see With", name, "GRPCStartWorkflows.")
+	g.P(name, `WorkflowIDHeader = "temporal-workflow-id"`)
+	g.P("// ", name, "RunIDHeader is the gRPC response header with the run ID of the workflow")
+	g.P("// which a ", name, " rpc starts, see With", name, "GRPCStartWorkflows.")
+	g.P(name, `RunIDHeader = "temporal-run-id"`)
+	g.P(")")
+	g.P()
+
+	g.P("type ", serverName, " struct {")
+	g.P("Unimplemented", name, "Server")
+	g.P("c *", unexport(name+interfaceSuffix))
+	g.P("start bool")
+	g.P("}")
+	g.P()
+
+	g.P("// ", optionName, " configures the gRPC server of ", name, ", see New", name, "GRPCServer.")
+	g.P("type ", optionName, " func(*", serverName, ")")
+	g.P()
+
+	g.P("// With", name, "GRPCStartWorkflows makes workflow rpcs return as soon as their")
+	g.P("// workflows start, instead of when they complete. Their responses are empty")
+	g.P("// output messages (not the workflows' outputs, even if they complete at once),")
+	g.P("// and their headers contain the workflow and run IDs, see ", name, "WorkflowIDHeader")
+	g.P("// and ", name, "RunIDHeader. Clients can read them with the grpc.Header call option.")
+	g.P("func With", name, "GRPCStartWorkflows() ", optionName, " {")
+	g.P("return func(s *", serverName, ") {")
+	g.P("s.start = true")
+	g.P("}")
+	g.P("}")
+	g.P()
+
+	g.P("// New", name, "GRPCServer returns an implementation of ", name, "Server (generated")
+	g.P("// by protoc-gen-go-grpc) which runs the workflow of each workflow rpc with the")
+	g.P("// given client, and by default waits for its completion. Temporal errors are")
+	g.P("// converted to gRPC status errors. Other rpcs are unimplemented.")
+	if usesMetrics(service) {
+		g.P("//")
+		g.P("// The given metrics handler is used like in New", name+interfaceSuffix, ".")
+		g.P("func New", name, "GRPCServer(c ", clientPackage.Ident("Client"), ", m ", clientPackage.Ident("MetricsHandler"), ", opts ...", optionName, ") ", name, "Server {")
+		g.P("s := &", serverName, "{c: new", name, interfaceSuffix, "(c, m)}")
+	} else {
+		g.P("func New", name, "GRPCServer(c ", clientPackage.Ident("Client"), ", opts ...", optionName, ") ", name, "Server {")
+		g.P("s := &", serverName, "{c: new", name, interfaceSuffix, "(c)}")
+	}
+	g.P("for _, o := range opts {")
+	g.P("o(s)")
+	g.P("}")
+	g.P("return s")
+	g.P("}")
+	g.P()
+
+	prefix := cfg.helperPrefix(name)
+	for _, method := range service.Methods {
+		if isWorkflow(method) {
+			grpcMethod(g, method, serverName, prefix)
+		}
+	}
+
+	grpcError(g, service)
+}
+
+func grpcMethod(g *protogen.GeneratedFile, method *protogen.Method, serverName, prefix string) {
+	name := method.Parent.GoName
+	in := g.QualifiedGoIdent(method.Input.GoIdent)
+	out := g.QualifiedGoIdent(method.Output.GoIdent)
+	toStatus := grpcErrorFunc(method.Parent)
+
+	g.P("// ", method.GoName, " runs the workflow ", method.Desc.FullName(), ", or only starts")
+	g.P("// it with With", name, "GRPCStartWorkflows and then returns an empty ", out, ".")
+	g.P("func (s *", serverName, ") ", method.GoName, "(ctx ", contextPackage.Ident("Context"), ", in *", in, ") (*", out, ", error) {")
+	g.P("if s.start {")
+	g.P("run, err := s.c.StartWorkflow", prefix, method.GoName, "(ctx", inputArg(method), ")")
+	g.P("if err != nil {")
+	g.P("return nil, ", toStatus, "(err)")
+	g.P("}")
+	g.P("// This fails only outside of gRPC servers, e.g. in unit tests.")
+	g.P("_ = ", grpcPackage.Ident("SetHeader"), "(ctx, ", metadataPackage.Ident("Pairs"), "(", name, "WorkflowIDHeader, run.GetID(), ", name, "RunIDHeader, run.GetRunID()))")
+	g.P("return &", out, "{}, nil")
+	g.P("}")
+	if isEmpty(method.Output) {
+		g.P("if err := s.c.ExecuteWorkflow", prefix, method.GoName, "(ctx", inputArg(method), "); err != nil {")
+		g.P("return nil, ", toStatus, "(err)")
+		g.P("}")
+		g.P("return &", out, "{}, nil")
+	} else {
+		g.P("out, err := s.c.ExecuteWorkflow", prefix, method.GoName, "(ctx", inputArg(method), ")")
+		g.P("if err != nil {")
+		g.P("return nil, ", toStatus, "(err)")
+		g.P("}")
+		g.P("return out, nil")
+	}
+	g.P("}")
+	g.P()
+}
+
+func grpcError(g *protogen.GeneratedFile, service *protogen.Service) {
+	g.P("// ", grpcErrorFunc(service), " converts an error of a workflow of ", service.GoName, " to a gRPC")
+	g.P("// status error: workflows which already started are AlreadyExists, timeouts are")
+	g.P("// DeadlineExceeded, cancellations are Canceled, and terminations are Aborted.")
+	if anyInputConstraints(service) {
+		g.P("// Invalid inputs are InvalidArgument, and other application errors are Unknown.")
+	} else {
+		g.P("// Application errors are Unknown.")
+	}
+	g.P("// Errors of the Temporal service keep their own codes.")
+	g.P("func ", grpcErrorFunc(service), "(err error) error {")
+	g.P("var timeoutErr *", temporalPackage.Ident("TimeoutError"))
+	g.P("var canceledErr *", temporalPackage.Ident("CanceledError"))
+	g.P("var terminatedErr *", temporalPackage.Ident("TerminatedError"))
+	g.P("var appErr *", temporalPackage.Ident("ApplicationError"))
+	g.P("code := ", serviceerrorPackage.Ident("ToStatus"), "(err).Code()")
+	g.P("switch {")
+	g.P("case ", temporalPackage.Ident("IsWorkflowExecutionAlreadyStartedError"), "(err):")
+	g.P("code = ", codesPackage.Ident("AlreadyExists"))
+	g.P("case ", errorsPackage.Ident("As"), "(err, &timeoutErr):")
+	g.P("code = ", codesPackage.Ident("DeadlineExceeded"))
+	g.P("case ", errorsPackage.Ident("As"), "(err, &canceledErr):")
+	g.P("code = ", codesPackage.Ident("Canceled"))
+	g.P("case ", errorsPackage.Ident("As"), "(err, &terminatedErr):")
+	g.P("code = ", codesPackage.Ident("Aborted"))
+	if anyInputConstraints(service) {
+		g.P("case ", errorsPackage.Ident("As"), "(err, &appErr) && appErr.Type() == ", service.GoName, validationErrorType, "Type:")
+		g.P("code = ", codesPackage.Ident("InvalidArgument"))
+	}
+	g.P("case ", errorsPackage.Ident("As"), "(err, &appErr):")
+	g.P("code = ", codesPackage.Ident("Unknown"))
+	g.P("}")
+	g.P("return ", statusPackage.Ident("Error"), "(code, err.Error())")
+	g.P("}")
+	g.P()
+}
+
+func grpcErrorFunc(service *protogen.Service) string {
+	return unexport(service.GoName) + "GRPCError"
+}
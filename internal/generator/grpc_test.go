@@ -0,0 +1,234 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+// TestGRPCError checks which gRPC status code the generated error conversion
+// function of a service returns for real Temporal errors, by running a test
+// of the generated code in a temporary module with the Temporal SDK.
+func TestGRPCError(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping test which builds the Temporal SDK in short mode")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found:", err)
+	}
+
+	tests := []struct {
+		name           string
+		constraints    bool
+		wantValidation string
+	}{
+		{
+			name:           "without_input_constraints",
+			wantValidation: "Unknown",
+		},
+		{
+			name:           "with_input_constraints",
+			constraints:    true,
+			wantValidation: "InvalidArgument",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen, service := testService(t, tt.constraints)
+			g := gen.NewGeneratedFile("foo_temporal.pb.go", service.Methods[0].Input.GoIdent.GoImportPath)
+			g.P("package foo")
+			g.P()
+			// Generated with the input validation, which isn't needed here.
+			g.P("const ", service.GoName, validationErrorType, "Type = ", strconv.Quote(validationErrorType))
+			g.P()
+			grpcError(g, service)
+			b, err := g.Content()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			dir := t.TempDir()
+			writeFile(t, filepath.Join(dir, "go.mod"), grpcErrorGoMod)
+			writeFile(t, filepath.Join(dir, "foo_temporal.pb.go"), string(b))
+			writeFile(t, filepath.Join(dir, "foo_test.go"), fmt.Sprintf(grpcErrorTest, grpcErrorFunc(service), tt.wantValidation))
+
+			// The SDK's dependencies may be missing in a sandbox without
+			// network access: this isn't a failure of the generated code.
+			if out, err := runGo(dir, goBin, "mod", "tidy"); err != nil {
+				t.Skipf("failed to download the Temporal SDK: %v\n%s", err, out)
+			}
+			if out, err := runGo(dir, goBin, "test", "./..."); err != nil {
+				t.Fatalf("test of the generated code failed: %v\n%s", err, out)
+			}
+		})
+	}
+}
+
+// grpcErrorGoMod is the go.mod file of the module which tests the generated
+// error conversion function in [TestGRPCError].
+const grpcErrorGoMod = `module example.com/foo
+
+go 1.20
+
+require (
+	go.temporal.io/api v1.23.0
+	go.temporal.io/sdk v1.23.0
+	golang.org/x/sys v0.12.0
+	google.golang.org/grpc v1.55.0
+)
+`
+
+// grpcErrorTest is the test of the generated error conversion function in
+// [TestGRPCError], with the name of the function and the expected code of
+// validation errors as format arguments.
+const grpcErrorTest = `package foo
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/temporal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGRPCError(t *testing.T) {
+	timeoutErr := temporal.NewTimeoutError(enumspb.TIMEOUT_TYPE_START_TO_CLOSE, nil)
+	validationErr := temporal.NewNonRetryableApplicationError("invalid input", FooValidationErrorType, nil)
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{"already_started", serviceerror.NewWorkflowExecutionAlreadyStarted("started", "", ""), codes.AlreadyExists},
+		{"timeout", timeoutErr, codes.DeadlineExceeded},
+		{"canceled", temporal.NewCanceledError(), codes.Canceled},
+		{"terminated", &temporal.TerminatedError{}, codes.Aborted},
+		{"validation", validationErr, codes.%[2]s},
+		{"application", temporal.NewApplicationError("failed", "Fatal"), codes.Unknown},
+		{"application_with_timeout_cause", temporal.NewApplicationErrorWithCause("failed", "Fatal", timeoutErr), codes.DeadlineExceeded},
+		{"not_found", serviceerror.NewNotFound("not found"), codes.NotFound},
+		{"permission_denied", serviceerror.NewPermissionDenied("denied", ""), codes.PermissionDenied},
+		{"wrapped_canceled", fmt.Errorf("wrapped: %%w", temporal.NewCanceledError()), codes.Canceled},
+		{"wrapped_validation", fmt.Errorf("wrapped: %%w", validationErr), codes.%[2]s},
+		{"other", errors.New("other"), codes.Unknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := %[1]s(tt.err)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("%[1]s(%%v) = %%v, want code %%v", tt.err, err, tt.want)
+			}
+		})
+	}
+}
+`
+
+func writeFile(t *testing.T, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(name, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func runGo(dir, goBin string, args ...string) ([]byte, error) {
+	cmd := exec.Command(goBin, args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOWORK=off")
+	return cmd.CombinedOutput()
+}
+
+// testService returns a plugin with a single file, which defines a service
+// named Foo with a single workflow, and optionally with a protovalidate
+// constraint in the workflow's input.
+func testService(t *testing.T, constraints bool) (*protogen.Plugin, *protogen.Service) {
+	t.Helper()
+
+	fieldOpts := &descriptorpb.FieldOptions{}
+	if constraints {
+		// (buf.validate.field).string.min_len = 1, as an unknown field.
+		var b []byte
+		b = protowire.AppendTag(b, protovalidateExtensionNumber, protowire.BytesType)
+		b = protowire.AppendBytes(b, []byte{0x72, 0x02, 0x10, 0x01})
+		fieldOpts.ProtoReflect().SetUnknown(b)
+	}
+	methodOpts := &descriptorpb.MethodOptions{}
+	proto.SetExtension(methodOpts, workerpb.E_Workflow, &workerpb.Workflow{})
+	serviceOpts := &descriptorpb.ServiceOptions{}
+	proto.SetExtension(serviceOpts, workerpb.E_Worker, &workerpb.Worker{TaskQueue: "foo"})
+
+	f := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("foo.proto"),
+		Package: proto.String("foo"),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{GoPackage: proto.String("example.com/foo")},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("FooInput"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("name"),
+				JsonName: proto.String("name"),
+				Number:   proto.Int32(1),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				Options:  fieldOpts,
+			}},
+		}},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name:    proto.String("Foo"),
+			Options: serviceOpts,
+			Method: []*descriptorpb.MethodDescriptorProto{{
+				Name:       proto.String("Bar"),
+				InputType:  proto.String(".foo.FooInput"),
+				OutputType: proto.String(".foo.FooInput"),
+				Options:    methodOpts,
+			}},
+		}},
+	}
+	gen, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"foo.proto"},
+		ProtoFile:      []*descriptorpb.FileDescriptorProto{f},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gen, gen.Files[0].Services[0]
+}
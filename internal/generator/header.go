@@ -45,23 +45,23 @@ const (
 	// Syntax field number in "FileDescriptorProto" in
 	// https://github.com/protocolbuffers/protobuf/blob/main/src/google/protobuf/descriptor.proto.
 	fileDescriptorProtoSyntaxFieldNumber = 12
+
+	// Edition field number in "FileDescriptorProto" in
+	// https://github.com/protocolbuffers/protobuf/blob/main/src/google/protobuf/descriptor.proto.
+	// Protoc associates it (instead of the syntax field) with the "edition"
+	// declaration in files that use Protobuf Editions.
+	fileDescriptorProtoEditionFieldNumber = 14
 )
 
-func GenerateHeader(g *protogen.GeneratedFile, f *protogen.File, ver string) {
-	// Attach all comments associated with the syntax field.
+func GenerateHeader(g *protogen.GeneratedFile, f *protogen.File, ver string, cfg *Config) {
+	// Attach all comments associated with the syntax (or edition) field.
 	path := protoreflect.SourcePath{fileDescriptorProtoSyntaxFieldNumber}
+	if f.Desc.Syntax() == protoreflect.Editions {
+		path = protoreflect.SourcePath{fileDescriptorProtoEditionFieldNumber}
+	}
 	leadingComments(g, f.Desc.SourceLocations().ByPath(path))
 
-	g.P(fmt.Sprintf("// Code generated by %s. DO NOT EDIT.", Executable))
-	g.P("// versions:")
-	g.P("// - ", Executable, " v", Version)
-	alignment := len(Executable) - len("protoc") + 1
-	g.P("// - protoc", strings.Repeat(" ", alignment), ver)
-	if f.Proto.GetOptions().GetDeprecated() {
-		g.P("// ", f.Desc.Path(), " is a deprecated file.")
-	} else {
-		g.P("// source: ", f.Desc.Path())
-	}
+	generatedComment(g, f, ver, cfg)
 	g.P()
 
 	// Attach all comments associated with the package field.
@@ -72,6 +72,24 @@ func GenerateHeader(g *protogen.GeneratedFile, f *protogen.File, ver string) {
 	g.P()
 }
 
+// generatedComment generates the comment which marks a Go file as generated
+// from the given proto file, with the versions of the generator and protoc if
+// the config says so.
+func generatedComment(g *protogen.GeneratedFile, f *protogen.File, ver string, cfg *Config) {
+	g.P(fmt.Sprintf("// Code generated by %s. DO NOT EDIT.", Executable))
+	if cfg.VersionHeader {
+		g.P("// versions:")
+		g.P("// - ", Executable, " v", Version)
+		alignment := len(Executable) - len("protoc") + 1
+		g.P("// - protoc", strings.Repeat(" ", alignment), ver)
+	}
+	if f.Proto.GetOptions().GetDeprecated() {
+		g.P("// ", f.Desc.Path(), " is a deprecated file.")
+	} else {
+		g.P("// source: ", f.Desc.Path())
+	}
+}
+
 func leadingComments(g *protogen.GeneratedFile, loc protoreflect.SourceLocation) {
 	for _, s := range loc.LeadingDetachedComments {
 		g.P(protogen.Comments(s))
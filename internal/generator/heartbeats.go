@@ -0,0 +1,99 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+// GenerateHeartbeats generates typed helpers for recording and retrieving the
+// heartbeat details of activities, if their "heartbeat_details" are specified.
+func GenerateHeartbeats(g *protogen.GeneratedFile, service *protogen.Service, cfg *Config, idx *Index) {
+	prefix := cfg.helperPrefix(service.GoName)
+	for _, method := range service.Methods {
+		details, _ := idx.heartbeatDetails(method)
+		if details == nil {
+			continue
+		}
+		recordHeartbeat(g, method, prefix, details)
+		getHeartbeat(g, method, prefix, details)
+	}
+}
+
+func recordHeartbeat(g *protogen.GeneratedFile, method *protogen.Method, serviceName string, details *protogen.Message) {
+	name := "RecordHeartbeat" + serviceName + method.GoName
+	g.P("// ", name, " reports the progress of the ", method.GoName, " activity to Temporal.")
+	g.P("// The details are available to the next attempt of the activity if the current")
+	g.P("// one fails or times out - see ", "GetHeartbeat"+serviceName+method.GoName, ". For more information, see")
+	g.P("// https://docs.temporal.io/dev-guide/go/features#activity-heartbeats.")
+	g.P("func ", name, "(ctx ", contextPackage.Ident("Context"), ", details *", details.GoIdent, ") {")
+	g.P(activityPackage.Ident("RecordHeartbeat"), "(ctx, details)")
+	g.P("}")
+	g.P()
+}
+
+func getHeartbeat(g *protogen.GeneratedFile, method *protogen.Method, serviceName string, details *protogen.Message) {
+	name := "GetHeartbeat" + serviceName + method.GoName
+	g.P("// ", name, " returns the details of the last heartbeat which a previous")
+	g.P("// attempt of the ", method.GoName, " activity recorded, and whether there is one.")
+	g.P("func ", name, "(ctx ", contextPackage.Ident("Context"), ") (*", details.GoIdent, ", bool, error) {")
+	g.P("if !", activityPackage.Ident("HasHeartbeatDetails"), "(ctx) {")
+	g.P("return nil, false, nil")
+	g.P("}")
+	g.P("var details *", details.GoIdent)
+	g.P("if err := ", activityPackage.Ident("GetHeartbeatDetails"), "(ctx, &details); err != nil {")
+	g.P("return nil, false, err")
+	g.P("}")
+	g.P("return details, true, nil")
+	g.P("}")
+	g.P()
+}
+
+// heartbeatDetails returns the message which the given activity method reports
+// as heartbeat details, or nil if it doesn't specify one.
+func (idx *Index) heartbeatDetails(method *protogen.Method) (*protogen.Message, error) {
+	a := proto.GetExtension(method.Desc.Options(), workerpb.E_Activity).(*workerpb.Activity)
+	name := a.GetHeartbeatDetails()
+	if name == "" {
+		return nil, nil
+	}
+
+	path := method.Desc.ParentFile().Path()
+	if isWorkflow(method) {
+		return nil, fmt.Errorf("%s: rpc %s is a workflow, heartbeat details are supported only in activities",
+			path, method.Desc.FullName())
+	}
+	m := idx.message(method.Desc.ParentFile(), name)
+	if m == nil {
+		return nil, fmt.Errorf("%s: rpc %s references an undefined heartbeat details message %q",
+			path, method.Desc.FullName(), name)
+	}
+	return m, nil
+}
@@ -0,0 +1,124 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Index provides lookups across all the files in a protoc invocation,
+// including imported files which aren't generated.
+type Index struct {
+	files    map[string]*protogen.File
+	messages map[protoreflect.FullName]*protogen.Message
+	methods  map[protoreflect.FullName]*protogen.Method
+}
+
+func NewIndex(files []*protogen.File) *Index {
+	idx := &Index{
+		files:    make(map[string]*protogen.File, len(files)),
+		messages: map[protoreflect.FullName]*protogen.Message{},
+		methods:  map[protoreflect.FullName]*protogen.Method{},
+	}
+	for _, f := range files {
+		idx.files[f.Desc.Path()] = f
+		idx.addMessages(f.Messages)
+		for _, s := range f.Services {
+			for _, m := range s.Methods {
+				idx.methods[m.Desc.FullName()] = m
+			}
+		}
+	}
+	return idx
+}
+
+func (idx *Index) addMessages(messages []*protogen.Message) {
+	for _, m := range messages {
+		idx.messages[m.Desc.FullName()] = m
+		idx.addMessages(m.Messages)
+	}
+}
+
+// message returns the message with the given name, which is either relative
+// to the package of the given file, or fully qualified (with or without a
+// leading dot), or nil if there is no such message.
+func (idx *Index) message(f protoreflect.FileDescriptor, name string) *protogen.Message {
+	if strings.HasPrefix(name, ".") {
+		return idx.messages[protoreflect.FullName(name[1:])]
+	}
+	if pkg := f.Package(); pkg != "" {
+		if m, ok := idx.messages[protoreflect.FullName(string(pkg)+"."+name)]; ok {
+			return m
+		}
+	}
+	return idx.messages[protoreflect.FullName(name)]
+}
+
+// method returns the method with the given name, which is either relative to
+// the package of the given file, or fully qualified (with or without a leading
+// dot), or nil if there is no such method.
+func (idx *Index) method(f protoreflect.FileDescriptor, name string) *protogen.Method {
+	if strings.HasPrefix(name, ".") {
+		return idx.methods[protoreflect.FullName(name[1:])]
+	}
+	if pkg := f.Package(); pkg != "" {
+		if m, ok := idx.methods[protoreflect.FullName(string(pkg)+"."+name)]; ok {
+			return m
+		}
+	}
+	return idx.methods[protoreflect.FullName(name)]
+}
+
+// file returns the file which contains the given descriptor.
+func (idx *Index) file(d protoreflect.Descriptor) *protogen.File {
+	return idx.files[d.ParentFile().Path()]
+}
+
+// imports returns all the files which the given file imports, directly or
+// indirectly, in breadth-first order and without duplicates.
+func (idx *Index) imports(f *protogen.File) []*protogen.File {
+	var result []*protogen.File
+	seen := map[string]bool{f.Desc.Path(): true}
+	queue := []protoreflect.FileDescriptor{f.Desc}
+	for len(queue) > 0 {
+		imports := queue[0].Imports()
+		queue = queue[1:]
+		for i := 0; i < imports.Len(); i++ {
+			fd := imports.Get(i).FileDescriptor
+			if seen[fd.Path()] {
+				continue
+			}
+			seen[fd.Path()] = true
+			queue = append(queue, fd)
+			if imported, ok := idx.files[fd.Path()]; ok {
+				result = append(result, imported)
+			}
+		}
+	}
+	return result
+}
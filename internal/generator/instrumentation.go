@@ -0,0 +1,135 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// instrumentation generates the tracing and metrics code around a call in a
+// generated function, according to the options of the method's service.
+type instrumentation struct {
+	method  *protogen.Method
+	tracing bool
+	metrics bool
+
+	// handler is an expression of the metrics handler, in parts for g.P (so
+	// its package is imported only if it's generated), and side is the name
+	// of the constant of the metrics side tag.
+	handler []interface{}
+	side    string
+	// workflow indicates that the code runs in a workflow, so it must use
+	// the workflow's deterministic clock.
+	workflow bool
+}
+
+// clientInstrumentation instruments a client helper with a context.Context,
+// which creates client spans and records metrics with the client's handler.
+func clientInstrumentation(g *protogen.GeneratedFile, method *protogen.Method) *instrumentation {
+	i := &instrumentation{
+		method:  method,
+		handler: []interface{}{"c.m"},
+		side:    method.Parent.GoName + "ClientSide",
+	}
+	i.begin(g, "SpanKindClient")
+	return i
+}
+
+// workflowInstrumentation instruments a helper which runs in a workflow, and
+// records metrics with the workflow's handler. It doesn't create spans,
+// because they aren't deterministic.
+func workflowInstrumentation(g *protogen.GeneratedFile, method *protogen.Method) *instrumentation {
+	i := &instrumentation{
+		method:   method,
+		handler:  []interface{}{workflowPackage.Ident("GetMetricsHandler"), "(ctx)"},
+		side:     method.Parent.GoName + "ClientSide",
+		workflow: true,
+	}
+	i.begin(g, "")
+	return i
+}
+
+// workerInstrumentation instruments a registered workflow or activity. Only
+// activities create spans, see [workflowInstrumentation].
+func workerInstrumentation(g *protogen.GeneratedFile, method *protogen.Method) *instrumentation {
+	i := &instrumentation{
+		method:   method,
+		side:     method.Parent.GoName + "WorkerSide",
+		workflow: isWorkflow(method),
+	}
+	var kind string
+	if i.workflow {
+		i.handler = []interface{}{workflowPackage.Ident("GetMetricsHandler"), "(ctx)"}
+	} else {
+		i.handler = []interface{}{activityPackage.Ident("GetMetricsHandler"), "(ctx)"}
+		kind = "SpanKindServer"
+	}
+	i.begin(g, kind)
+	return i
+}
+
+func (i *instrumentation) begin(g *protogen.GeneratedFile, spanKind string) {
+	if spanKind != "" {
+		i.tracing = startSpan(g, i.method, spanKind)
+	}
+	i.metrics = usesMetrics(i.method.Parent)
+	if !i.metrics {
+		return
+	}
+	if i.workflow {
+		g.P("start := ", workflowPackage.Ident("Now"), "(ctx)")
+	} else {
+		g.P("start := ", timePackage.Ident("Now"), "()")
+	}
+}
+
+// enabled reports whether the generated function is instrumented at all.
+func (i *instrumentation) enabled() bool {
+	return i.tracing || i.metrics
+}
+
+// setWorkflowID generates code that adds the given workflow ID expression (in
+// parts for g.P) to the span.
+func (i *instrumentation) setWorkflowID(g *protogen.GeneratedFile, id ...interface{}) {
+	if i.tracing {
+		setSpanWorkflowID(g, id...)
+	}
+}
+
+// end generates code that records the metrics and ends the span, with the
+// given error expression.
+func (i *instrumentation) end(g *protogen.GeneratedFile, err string) {
+	if i.metrics {
+		latency := g.QualifiedGoIdent(timePackage.Ident("Since")) + "(start)"
+		if i.workflow {
+			latency = g.QualifiedGoIdent(workflowPackage.Ident("Now")) + "(ctx).Sub(start)"
+		}
+		args := append([]interface{}{recordMetricsFunc(i.method.Parent), "("}, i.handler...)
+		g.P(append(args, ", ", metricsMethodConst(i.method), ", ", i.side, ", ", latency, ", ", err, ")")...)
+	}
+	if i.tracing {
+		endSpan(g, err)
+	}
+}
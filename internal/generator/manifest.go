@@ -0,0 +1,264 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+const (
+	ManifestFilenameSuffix = "_temporal.json"
+
+	// ManifestSchemaVersion is the version of the schema of JSON manifests,
+	// which is documented in the README file. It's incremented only when the
+	// schema changes incompatibly, not when fields are added to it.
+	ManifestSchemaVersion = 1
+)
+
+type manifest struct {
+	SchemaVersion int            `json:"schema_version"`
+	Files         []manifestFile `json:"files"`
+}
+
+type manifestFile struct {
+	Name      string            `json:"name"`
+	Package   string            `json:"package"`
+	GoPackage string            `json:"go_package"`
+	Services  []manifestService `json:"services"`
+}
+
+type manifestService struct {
+	Name       string           `json:"name"`
+	Deprecated bool             `json:"deprecated,omitempty"`
+	Worker     *manifestWorker  `json:"worker,omitempty"`
+	Workflows  []manifestMethod `json:"workflows,omitempty"`
+	Activities []manifestMethod `json:"activities,omitempty"`
+}
+
+type manifestWorker struct {
+	TaskQueue string                 `json:"task_queue"`
+	Options   map[string]interface{} `json:"options,omitempty"`
+}
+
+type manifestMethod struct {
+	Name       string                 `json:"name"`
+	Type       string                 `json:"type"`
+	Input      string                 `json:"input"`
+	Output     string                 `json:"output"`
+	Deprecated bool                   `json:"deprecated,omitempty"`
+	Options    map[string]interface{} `json:"options,omitempty"`
+}
+
+// GenerateManifest generates a JSON manifest of the services, workers,
+// workflows and activities in the given files, with their resolved options.
+func GenerateManifest(g *protogen.GeneratedFile, files []*protogen.File, idx *Index) error {
+	m := manifest{SchemaVersion: ManifestSchemaVersion, Files: []manifestFile{}}
+	for _, f := range files {
+		mf := manifestFile{
+			Name:      f.Desc.Path(),
+			Package:   string(f.Desc.Package()),
+			GoPackage: string(f.GoImportPath),
+			Services:  []manifestService{},
+		}
+		for _, service := range f.Services {
+			mf.Services = append(mf.Services, serviceManifest(service, idx))
+		}
+		m.Files = append(m.Files, mf)
+	}
+
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode JSON manifest: %w", err)
+	}
+	_, err = g.Write(append(b, '\n'))
+	return err
+}
+
+func serviceManifest(service *protogen.Service, idx *Index) manifestService {
+	ms := manifestService{
+		Name:       string(service.Desc.FullName()),
+		Deprecated: service.Desc.Options().(*descriptorpb.ServiceOptions).GetDeprecated(),
+	}
+	w := proto.GetExtension(service.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker)
+	if w.GetTaskQueue() != "" {
+		ms.Worker = &manifestWorker{
+			TaskQueue: w.GetTaskQueue(),
+			Options:   manifestOptions(w.GetOptions()),
+		}
+	}
+
+	for _, method := range service.Methods {
+		mm := manifestMethod{
+			Name:       string(method.Desc.FullName()),
+			Type:       method.GoName,
+			Input:      string(method.Input.Desc.FullName()),
+			Output:     string(method.Output.Desc.FullName()),
+			Deprecated: method.Desc.Options().(*descriptorpb.MethodOptions).GetDeprecated(),
+			Options:    methodManifestOptions(method, idx),
+		}
+		if isWorkflow(method) {
+			ms.Workflows = append(ms.Workflows, mm)
+		} else {
+			ms.Activities = append(ms.Activities, mm)
+		}
+	}
+	return ms
+}
+
+// methodManifestOptions converts the effective options of the given method
+// (see [effectiveOptions]) like [manifestOptions], with the retry policy which
+// the generated code applies instead of a reference to a preset (see
+// [Index.resolvedRetryPolicy]).
+func methodManifestOptions(method *protogen.Method, idx *Index) map[string]interface{} {
+	opts := effectiveOptions(method)
+	var policy *commonpb.RetryPolicy
+	switch o := opts.(type) {
+	case *workerpb.StartWorkflowOptions:
+		policy = idx.resolvedRetryPolicy(method, o.GetRetryPolicyRef(), o.GetRetryPolicy())
+		o.Retry = nil
+	case *workerpb.ActivityOptions:
+		policy = idx.resolvedRetryPolicy(method, o.GetRetryPolicyRef(), o.GetRetryPolicy())
+		o.Retry = nil
+	}
+	values := manifestOptions(opts)
+	if policy != nil {
+		if values == nil {
+			values = map[string]interface{}{}
+		}
+		values["retry_policy"] = manifestRetryPolicy(policy)
+	}
+	return values
+}
+
+// manifestRetryPolicy converts a retry policy like [manifestOptions]. It's a
+// gogo/protobuf message whose durations are *time.Duration fields, which
+// protoreflect doesn't see.
+func manifestRetryPolicy(p *commonpb.RetryPolicy) map[string]interface{} {
+	values := map[string]interface{}{}
+	if p.InitialInterval != nil {
+		values["initial_interval"] = jsonDuration(durationpb.New(*p.InitialInterval))
+	}
+	if p.BackoffCoefficient != 0 {
+		values["backoff_coefficient"] = p.BackoffCoefficient
+	}
+	if p.MaximumInterval != nil {
+		values["maximum_interval"] = jsonDuration(durationpb.New(*p.MaximumInterval))
+	}
+	if p.MaximumAttempts != 0 {
+		values["maximum_attempts"] = p.MaximumAttempts
+	}
+	if len(p.NonRetryableErrorTypes) > 0 {
+		values["non_retryable_error_types"] = p.NonRetryableErrorTypes
+	}
+	return values
+}
+
+// manifestOptions converts the non-default fields of the given options to
+// JSON values, keyed by their proto field names.
+func manifestOptions(opts proto.Message) map[string]interface{} {
+	if opts == nil {
+		return nil
+	}
+	m := opts.ProtoReflect()
+	if !m.IsValid() {
+		return nil
+	}
+	values := map[string]interface{}{}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		values[string(fd.Name())] = manifestValue(fd, v)
+		return true
+	})
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+func manifestValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch {
+	case fd.IsList():
+		l := v.List()
+		items := make([]interface{}, l.Len())
+		for i := range items {
+			items[i] = manifestScalar(fd, l.Get(i))
+		}
+		return items
+	case fd.IsMap():
+		items := map[string]interface{}{}
+		v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			items[k.String()] = manifestScalar(fd.MapValue(), v)
+			return true
+		})
+		return items
+	default:
+		return manifestScalar(fd, v)
+	}
+}
+
+// manifestScalar converts a single value like the canonical JSON mapping of
+// proto3 (e.g. durations are strings such as "1.5s", and enums are names),
+// except that 64-bit integers are numbers.
+func manifestScalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if d, ok := v.Message().Interface().(*durationpb.Duration); ok {
+			return jsonDuration(d)
+		}
+		if m := manifestOptions(v.Message().Interface()); m != nil {
+			return m
+		}
+		return map[string]interface{}{}
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return int32(v.Enum())
+	case protoreflect.BytesKind:
+		return v.Bytes()
+	default:
+		return v.Interface()
+	}
+}
+
+// jsonDuration formats a duration like its canonical JSON mapping.
+func jsonDuration(d *durationpb.Duration) string {
+	sign, secs, nanos := "", d.GetSeconds(), d.GetNanos()
+	if secs < 0 || nanos < 0 {
+		sign, secs, nanos = "-", -secs, -nanos
+	}
+	s := fmt.Sprintf("%s%d.%09d", sign, secs, nanos)
+	return strings.TrimSuffix(strings.TrimRight(s, "0"), ".") + "s"
+}
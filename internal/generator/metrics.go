@@ -0,0 +1,110 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+// Metric names and tags, which are the same in all services, so dashboards
+// can aggregate them across services.
+const (
+	callsMetric    = "proto_method_calls"
+	failuresMetric = "proto_method_failures"
+	latencyMetric  = "proto_method_latency"
+
+	serviceTag = "proto_service"
+	methodTag  = "proto_method"
+	sideTag    = "proto_side"
+)
+
+// GenerateMetrics generates constants with the names and tags of the metrics
+// which the given service records, and a function which records them, if
+// the service opts into metrics.
+func GenerateMetrics(g *protogen.GeneratedFile, service *protogen.Service) {
+	if !usesMetrics(service) {
+		return
+	}
+
+	name := service.GoName
+	g.P("// Metrics which the generated helpers of ", name, " and its registered")
+	g.P("// workflows and activities record with a ", clientPackage.Ident("MetricsHandler"), ", tagged by")
+	g.P("// proto service, method, and side (client or worker).")
+	g.P("const (")
+	g.P(name, "CallsMetric = ", strconv.Quote(callsMetric))
+	g.P(name, "FailuresMetric = ", strconv.Quote(failuresMetric))
+	g.P(name, "LatencyMetric = ", strconv.Quote(latencyMetric))
+	g.P()
+	g.P(name, "ServiceTag = ", strconv.Quote(serviceTag))
+	g.P(name, "MethodTag = ", strconv.Quote(methodTag))
+	g.P(name, "SideTag = ", strconv.Quote(sideTag))
+	g.P()
+	g.P(name, "ServiceName = ", strconv.Quote(string(service.Desc.FullName())))
+	for _, method := range service.Methods {
+		g.P(metricsMethodConst(method), " = ", strconv.Quote(string(method.Desc.Name())))
+	}
+	g.P(name, "ClientSide = \"client\"")
+	g.P(name, "WorkerSide = \"worker\"")
+	g.P(")")
+	g.P()
+
+	f := recordMetricsFunc(service)
+	g.P("// ", f, " records a call of a method of ", name, ", with its latency")
+	g.P("// and failure (if err isn't nil, and doesn't continue the workflow as new).")
+	g.P("func ", f, "(h ", clientPackage.Ident("MetricsHandler"), ", method, side string, latency ", timePackage.Ident("Duration"), ", err error) {")
+	g.P("if h == nil {")
+	g.P("return")
+	g.P("}")
+	g.P("h = h.WithTags(map[string]string{")
+	g.P(name, "ServiceTag: ", name, "ServiceName,")
+	g.P(name, "MethodTag: method,")
+	g.P(name, "SideTag: side,")
+	g.P("})")
+	g.P("h.Counter(", name, "CallsMetric).Inc(1)")
+	g.P("h.Timer(", name, "LatencyMetric).Record(latency)")
+	g.P("if err != nil && !", workflowPackage.Ident("IsContinueAsNewError"), "(err) {")
+	g.P("h.Counter(", name, "FailuresMetric).Inc(1)")
+	g.P("}")
+	g.P("}")
+	g.P()
+}
+
+func usesMetrics(service *protogen.Service) bool {
+	w := proto.GetExtension(service.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker)
+	return w.GetMetrics()
+}
+
+func metricsMethodConst(method *protogen.Method) string {
+	return method.Parent.GoName + method.GoName + "Method"
+}
+
+func recordMetricsFunc(service *protogen.Service) string {
+	return "record" + service.GoName + "Metrics"
+}
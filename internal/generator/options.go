@@ -0,0 +1,177 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+// option is a field value in a proto options message, and the name of the
+// corresponding field in a Temporal Go SDK options struct.
+type option struct {
+	value  interface{}
+	goName string
+}
+
+// nonDefaultOptions generates struct fields for all the given options which
+// don't have zero values (i.e. which don't have the SDK's default values).
+func nonDefaultOptions(g *protogen.GeneratedFile, options []option) {
+	for _, option := range options {
+		switch v := option.value.(type) {
+		case *bool:
+			if v != nil {
+				option.value = *v
+			}
+		case *int32:
+			if v != nil {
+				option.value = *v
+			}
+		case *string:
+			if v != nil {
+				option.value = *v
+			}
+		case *enumspb.WorkflowIdReusePolicy:
+			if v != nil {
+				option.value = *v
+			}
+		}
+
+		if v, ok := option.value.(bool); ok && v {
+			g.P(option.goName, ": ", v, ",")
+			continue
+		}
+		if v, ok := option.value.(float64); ok && v != 0 {
+			g.P(option.goName, ": ", v, ",")
+			continue
+		}
+		if v, ok := option.value.(int32); ok && v != 0 {
+			g.P(option.goName, ": ", v, ",")
+			continue
+		}
+		if v, ok := option.value.(string); ok && v != "" {
+			g.P(option.goName, ": ", strconv.Quote(v), ",")
+			continue
+		}
+		if v, ok := option.value.([]string); ok && len(v) > 0 {
+			g.P(option.goName, ": []string{")
+			for _, s := range v {
+				g.P(strconv.Quote(s), ",")
+			}
+			g.P("},")
+			continue
+		}
+		if v, ok := option.value.(*durationpb.Duration); ok && v != nil {
+			durationOption(g, option.goName, v.AsDuration())
+			continue
+		}
+		if v, ok := option.value.(*time.Duration); ok && v != nil {
+			durationOption(g, option.goName, *v)
+			continue
+		}
+		if v, ok := option.value.(workerpb.WorkflowPanicPolicy); ok && v == workerpb.WorkflowPanicPolicy_WORKFLOW_PANIC_POLICY_FAIL_WORKFLOW {
+			g.P(option.goName, ": ", workerPackage.Ident("FailWorkflow"), ",")
+			continue
+		}
+		if v, ok := option.value.(workerpb.VersioningIntent); ok && v != workerpb.VersioningIntent_VERSIONING_INTENT_UNSPECIFIED {
+			g.P(option.goName, ": ", temporalPackage.Ident(versioningIntentName(v)), ",")
+			continue
+		}
+		if v, ok := option.value.(enumspb.WorkflowIdReusePolicy); ok && v != enumspb.WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+			g.P(option.goName, ": ", enumsPackage.Ident(workflowIDReusePolicyName(v)), ",")
+			continue
+		}
+		if v, ok := option.value.(protogen.GoIdent); ok {
+			g.P(option.goName, ": ", v, ",")
+			continue
+		}
+		if v, ok := option.value.(*commonpb.RetryPolicy); ok && v != nil {
+			g.P(option.goName, ": &", temporalPackage.Ident("RetryPolicy"), "{")
+			nonDefaultRetryPolicy(g, v)
+			g.P("},")
+			continue
+		}
+	}
+}
+
+func durationOption(g *protogen.GeneratedFile, goName string, d time.Duration) {
+	g.P(goName, ": ", durationValue(g, d), ",")
+}
+
+// durationValue returns a Go expression for the given duration.
+func durationValue(g *protogen.GeneratedFile, d time.Duration) string {
+	return fmt.Sprint(g.QualifiedGoIdent(timePackage.Ident("Duration")), "(", d.Seconds(), " * float64(time.Second))")
+}
+
+func nonDefaultRetryPolicy(g *protogen.GeneratedFile, p *commonpb.RetryPolicy) {
+	nonDefaultOptions(g, []option{
+		{
+			p.InitialInterval,
+			"InitialInterval",
+		},
+		{
+			p.BackoffCoefficient,
+			"BackoffCoefficient",
+		},
+		{
+			p.MaximumInterval,
+			"MaximumInterval",
+		},
+		{
+			p.MaximumAttempts,
+			"MaximumAttempts",
+		},
+		{
+			p.NonRetryableErrorTypes,
+			"NonRetryableErrorTypes",
+		},
+	})
+}
+
+// workflowIDReusePolicyName returns the name of the Go constant of the given
+// enum value. Note that the String method of this (gogoproto-generated)
+// enum type returns a different, CamelCase name.
+func workflowIDReusePolicyName(v enumspb.WorkflowIdReusePolicy) string {
+	fd := (&workerpb.StartWorkflowOptions{}).ProtoReflect().Descriptor().Fields().ByName("workflow_id_reuse_policy")
+	return string(fd.Enum().Values().ByNumber(protoreflect.EnumNumber(v)).Name())
+}
+
+// versioningIntentName returns the name of the Go SDK constant which matches
+// the given (specified) versioning intent, e.g. "VersioningIntentCompatible".
+func versioningIntentName(v workerpb.VersioningIntent) string {
+	if v == workerpb.VersioningIntent_VERSIONING_INTENT_DEFAULT {
+		return "VersioningIntentDefault"
+	}
+	return "VersioningIntentCompatible"
+}
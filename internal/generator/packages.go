@@ -30,10 +30,41 @@ import (
 
 const (
 	contextPackage = protogen.GoImportPath("context")
+	errorsPackage  = protogen.GoImportPath("errors")
+	flagPackage    = protogen.GoImportPath("flag")
+	fmtPackage     = protogen.GoImportPath("fmt")
+	ioPackage      = protogen.GoImportPath("io")
+	jsonPackage    = protogen.GoImportPath("encoding/json")
 	logPackage     = protogen.GoImportPath("log")
+	osPackage      = protogen.GoImportPath("os")
+	stringsPackage = protogen.GoImportPath("strings")
+	syncPackage    = protogen.GoImportPath("sync")
 	timePackage    = protogen.GoImportPath("time")
 
-	clientPackage   = protogen.GoImportPath("go.temporal.io/sdk/client")
-	workerPackage   = protogen.GoImportPath("go.temporal.io/sdk/worker")
-	workflowPackage = protogen.GoImportPath("go.temporal.io/sdk/workflow")
+	emptypbPackage   = protogen.GoImportPath("google.golang.org/protobuf/types/known/emptypb")
+	protoPackage     = protogen.GoImportPath("google.golang.org/protobuf/proto")
+	protojsonPackage = protogen.GoImportPath("google.golang.org/protobuf/encoding/protojson")
+
+	protovalidatePackage = protogen.GoImportPath("github.com/bufbuild/protovalidate-go")
+	tracePackage         = protogen.GoImportPath("go.opentelemetry.io/otel/trace")
+
+	grpcPackage     = protogen.GoImportPath("google.golang.org/grpc")
+	codesPackage    = protogen.GoImportPath("google.golang.org/grpc/codes")
+	metadataPackage = protogen.GoImportPath("google.golang.org/grpc/metadata")
+	statusPackage   = protogen.GoImportPath("google.golang.org/grpc/status")
+
+	enumsPackage        = protogen.GoImportPath("go.temporal.io/api/enums/v1")
+	serviceerrorPackage = protogen.GoImportPath("go.temporal.io/api/serviceerror")
+
+	activityPackage    = protogen.GoImportPath("go.temporal.io/sdk/activity")
+	clientPackage      = protogen.GoImportPath("go.temporal.io/sdk/client")
+	converterPackage   = protogen.GoImportPath("go.temporal.io/sdk/converter")
+	interceptorPackage = protogen.GoImportPath("go.temporal.io/sdk/interceptor")
+	temporalPackage    = protogen.GoImportPath("go.temporal.io/sdk/temporal")
+	workerPackage      = protogen.GoImportPath("go.temporal.io/sdk/worker")
+	workflowPackage    = protogen.GoImportPath("go.temporal.io/sdk/workflow")
+
+	claimcheckPackage = protogen.GoImportPath("github.com/daabr/protoc-gen-temporal-go/claimcheck")
+	sensitivePackage  = protogen.GoImportPath("github.com/daabr/protoc-gen-temporal-go/sensitive")
+	tracingPackage    = protogen.GoImportPath("github.com/daabr/protoc-gen-temporal-go/tracing")
 )
@@ -0,0 +1,233 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	commonpb "go.temporal.io/api/common/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+const (
+	retryPolicyInfix = "RetryPolicy"
+)
+
+var identifierRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// GenerateRetryPolicies generates exported variables for all the retry policy
+// presets that are defined in the given file and its services.
+func GenerateRetryPolicies(g *protogen.GeneratedFile, f *protogen.File) {
+	for _, p := range filePresets(f) {
+		retryPolicyVar(g, p, retryPolicyInfix+goCamelCase(p.Name), "file "+f.Desc.Path())
+	}
+	for _, service := range f.Services {
+		for _, p := range servicePresets(service) {
+			scope := "service " + string(service.Desc.Name())
+			retryPolicyVar(g, p, service.GoName+retryPolicyInfix+goCamelCase(p.Name), scope)
+		}
+	}
+}
+
+func retryPolicyVar(g *protogen.GeneratedFile, p *workerpb.RetryPolicyPreset, goName, scope string) {
+	g.P("// ", goName, " is the retry policy preset ", fmt.Sprintf("%q", p.Name), " of ", scope, ".")
+	g.P("var ", goName, " = &", temporalPackage.Ident("RetryPolicy"), "{")
+	if p.Policy != nil {
+		nonDefaultRetryPolicy(g, p.Policy)
+	}
+	g.P("}")
+	g.P()
+}
+
+// HasRetryPolicies reports whether the given file, or any of its services,
+// defines retry policy presets.
+func HasRetryPolicies(f *protogen.File) bool {
+	if len(filePresets(f)) > 0 {
+		return true
+	}
+	for _, service := range f.Services {
+		if len(servicePresets(service)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func filePresets(f *protogen.File) []*workerpb.RetryPolicyPreset {
+	return proto.GetExtension(f.Desc.Options(), workerpb.E_File).(*workerpb.File).GetRetryPolicies()
+}
+
+func servicePresets(service *protogen.Service) []*workerpb.RetryPolicyPreset {
+	return proto.GetExtension(service.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker).GetRetryPolicies()
+}
+
+// ValidateRetryPolicies reports invalid or duplicate retry policy presets in
+// the given files, including presets in different files of the same Go
+// package, whose generated variables would conflict.
+func ValidateRetryPolicies(files []*protogen.File) error {
+	goNames := map[protogen.GoIdent]string{}
+	check := func(p *workerpb.RetryPolicyPreset, ident protogen.GoIdent, location string) error {
+		if !identifierRegexp.MatchString(p.Name) {
+			return fmt.Errorf("%s: invalid retry policy preset name %q", location, p.Name)
+		}
+		if other, ok := goNames[ident]; ok {
+			return fmt.Errorf("%s: retry policy preset %q is already defined in %s", location, p.Name, other)
+		}
+		goNames[ident] = location
+		return nil
+	}
+
+	for _, f := range files {
+		for _, p := range filePresets(f) {
+			ident := f.GoImportPath.Ident(retryPolicyInfix + goCamelCase(p.Name))
+			if err := check(p, ident, f.Desc.Path()); err != nil {
+				return err
+			}
+		}
+		for _, service := range f.Services {
+			for _, p := range servicePresets(service) {
+				ident := f.GoImportPath.Ident(service.GoName + retryPolicyInfix + goCamelCase(p.Name))
+				location := fmt.Sprintf("%s: service %s", f.Desc.Path(), service.Desc.FullName())
+				if err := check(p, ident, location); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// resolveRetryPolicy returns the retry policy preset that the given method
+// references by name, and its Go variable, based on the resolution order which
+// is documented in the "RetryPolicyPreset" message.
+func (idx *Index) resolveRetryPolicy(method *protogen.Method, ref string) (*workerpb.RetryPolicyPreset, protogen.GoIdent, error) {
+	f := idx.file(method.Desc)
+	for _, p := range servicePresets(method.Parent) {
+		if p.Name == ref {
+			return p, f.GoImportPath.Ident(method.Parent.GoName + retryPolicyInfix + goCamelCase(p.Name)), nil
+		}
+	}
+	for _, p := range filePresets(f) {
+		if p.Name == ref {
+			return p, f.GoImportPath.Ident(retryPolicyInfix + goCamelCase(p.Name)), nil
+		}
+	}
+
+	var found []*protogen.File
+	var preset *workerpb.RetryPolicyPreset
+	for _, imported := range idx.imports(f) {
+		for _, p := range filePresets(imported) {
+			if p.Name == ref {
+				found = append(found, imported)
+				preset = p
+			}
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, protogen.GoIdent{}, fmt.Errorf("%s: rpc %s references an undefined retry policy preset %q",
+			f.Desc.Path(), method.Desc.FullName(), ref)
+	case 1:
+		return preset, found[0].GoImportPath.Ident(retryPolicyInfix + goCamelCase(ref)), nil
+	default:
+		var paths []string
+		for _, imported := range found {
+			paths = append(paths, imported.Desc.Path())
+		}
+		return nil, protogen.GoIdent{}, fmt.Errorf("%s: rpc %s references an ambiguous retry policy preset %q (defined in: %s)",
+			f.Desc.Path(), method.Desc.FullName(), ref, strings.Join(paths, ", "))
+	}
+}
+
+// retryPolicyOption returns the value of a "RetryPolicy" option: either the
+// Go variable of a retry policy preset, or an inline retry policy, or nil.
+// If the method declares non-retryable error types, they are added to a copy
+// of the retry policy, which is always inline. Workflows without a retry
+// policy don't get one, because unlike activities they aren't retried by
+// default, and any retry policy would enable retries. References are assumed
+// to be valid, i.e. already checked by [ValidateService].
+func (idx *Index) retryPolicyOption(method *protogen.Method, ref string, policy *commonpb.RetryPolicy) interface{} {
+	nonRetryable := nonRetryableErrorTypes(method)
+	if isWorkflow(method) && ref == "" && policy == nil {
+		return nil
+	}
+	if ref != "" {
+		preset, ident, _ := idx.resolveRetryPolicy(method, ref)
+		if len(nonRetryable) == 0 {
+			return ident
+		}
+		policy = preset.GetPolicy()
+	}
+	if len(nonRetryable) == 0 {
+		return policy
+	}
+
+	p := &commonpb.RetryPolicy{}
+	if policy != nil {
+		p = gogoproto.Clone(policy).(*commonpb.RetryPolicy)
+	}
+	seen := map[string]bool{}
+	p.NonRetryableErrorTypes = nil
+	for _, t := range append(policy.GetNonRetryableErrorTypes(), nonRetryable...) {
+		if !seen[t] {
+			seen[t] = true
+			p.NonRetryableErrorTypes = append(p.NonRetryableErrorTypes, t)
+		}
+	}
+	return p
+}
+
+// resolvedRetryPolicy returns the retry policy which the code generated by
+// [Index.retryPolicyOption] applies, i.e. with a resolved reference and the
+// non-retryable error types of the method, or nil if there isn't one.
+func (idx *Index) resolvedRetryPolicy(method *protogen.Method, ref string, policy *commonpb.RetryPolicy) *commonpb.RetryPolicy {
+	switch v := idx.retryPolicyOption(method, ref, policy).(type) {
+	case protogen.GoIdent:
+		preset, _, _ := idx.resolveRetryPolicy(method, ref)
+		return preset.GetPolicy()
+	case *commonpb.RetryPolicy:
+		return v
+	}
+	return nil
+}
+
+// goCamelCase converts a preset name (which matches identifierRegexp)
+// to an exported Go identifier, e.g. "foo_bar" to "FooBar".
+func goCamelCase(s string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(s, "_") {
+		if part == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
@@ -0,0 +1,234 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// protovalidateExtensionNumber is the field number of the "buf.validate"
+// extensions of message, oneof and field options, which specify constraints
+// for https://github.com/bufbuild/protovalidate. The generator doesn't depend
+// on their definitions, which may be unknown fields in the options.
+const protovalidateExtensionNumber = 1159
+
+// validationErrorType is the type of the application errors which the
+// generated code returns for inputs that violate their constraints.
+const validationErrorType = "ValidationError"
+
+// GenerateInputValidation generates a function which validates the inputs of
+// the given service's methods, if any of them have protovalidate constraints.
+func GenerateInputValidation(g *protogen.GeneratedFile, service *protogen.Service, cfg *Config) {
+	if !anyInputConstraints(service) {
+		return
+	}
+
+	errorType := service.GoName + validationErrorType + "Type"
+	g.P("// ", errorType, " is the type of the non-retryable application errors which")
+	g.P("// the generated functions of ", service.GoName, " return for invalid inputs.")
+	g.P("const ", errorType, ` = "`, validationErrorType, `"`)
+	g.P()
+
+	name := validateInputFunc(service)
+	g.P("var ", name, "Once ", syncPackage.Ident("Once"))
+	g.P("var ", name, "Validator *", protovalidatePackage.Ident("Validator"))
+	g.P("var ", name, "Err error")
+	g.P()
+	g.P("// ", name, " validates an input of ", service.GoName, " with its protovalidate")
+	g.P("// constraints, and returns a non-retryable application error if it's invalid.")
+	g.P("func ", name, "(in ", protoPackage.Ident("Message"), ") error {")
+	g.P(name, "Once.Do(func() {")
+	g.P(name, "Validator, ", name, "Err = ", protovalidatePackage.Ident("New"), "()")
+	g.P("})")
+	g.P("if ", name, "Err != nil {")
+	g.P("return ", name, "Err")
+	g.P("}")
+	g.P("if err := ", name, "Validator.Validate(in); err != nil {")
+	g.P("return ", temporalPackage.Ident("NewNonRetryableApplicationError"), "(err.Error(), ", errorType, ", err)")
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+
+	if cfg.ChildWorkflows && anyWorkflowInputConstraints(service) {
+		failedChildWorkflowFutureType(g, service)
+	}
+}
+
+// failedChildWorkflowFutureType generates a workflow.ChildWorkflowFuture
+// which has already failed, for child workflows with invalid inputs: unlike
+// workflow.Future, there is no way to create one with the SDK.
+func failedChildWorkflowFutureType(g *protogen.GeneratedFile, service *protogen.Service) {
+	name := failedChildWorkflowFutureName(service)
+	g.P("// ", name, " is a child workflow future which has already failed,")
+	g.P("// because the input of the child workflow is invalid. The child workflow")
+	g.P("// doesn't start, so signals fail too.")
+	g.P("type ", name, " struct {")
+	g.P(workflowPackage.Ident("Future"))
+	g.P("}")
+	g.P()
+	g.P("func (f ", name, ") GetChildWorkflowExecution() ", workflowPackage.Ident("Future"), " {")
+	g.P("return f.Future")
+	g.P("}")
+	g.P()
+	g.P("func (f ", name, ") SignalChildWorkflow(", workflowPackage.Ident("Context"), ", string, interface{}) ", workflowPackage.Ident("Future"), " {")
+	g.P("return f.Future")
+	g.P("}")
+	g.P()
+}
+
+// validateInput generates a pre-flight validation of the input of a method,
+// if it has protovalidate constraints, with the given failure statements.
+func validateInput(g *protogen.GeneratedFile, method *protogen.Method, failure ...string) {
+	if !hasInputConstraints(method) {
+		return
+	}
+	g.P("if err := ", validateInputFunc(method.Parent), "(in); err != nil {")
+	for _, line := range failure {
+		g.P(line)
+	}
+	g.P("}")
+}
+
+// errorReturn returns the statement which returns an error from a generated
+// function whose results are outputResult.
+func errorReturn(method *protogen.Method) string {
+	if isEmpty(method.Output) {
+		return "return err"
+	}
+	return "return nil, err"
+}
+
+// failedFuture returns the statements which return a workflow.Future that is
+// already resolved with an error.
+func failedFuture(g *protogen.GeneratedFile) []string {
+	return []string{
+		"f, s := " + g.QualifiedGoIdent(workflowPackage.Ident("NewFuture")) + "(ctx)",
+		"s.SetError(err)",
+		"return f",
+	}
+}
+
+// failedChildFuture returns the statements which return a
+// workflow.ChildWorkflowFuture that is already resolved with an error.
+func failedChildFuture(g *protogen.GeneratedFile, service *protogen.Service) []string {
+	return []string{
+		"f, s := " + g.QualifiedGoIdent(workflowPackage.Ident("NewFuture")) + "(ctx)",
+		"s.SetError(err)",
+		"return " + failedChildWorkflowFutureName(service) + "{f}",
+	}
+}
+
+func failedChildWorkflowFutureName(service *protogen.Service) string {
+	return unexport(service.GoName) + "FailedChildWorkflowFuture"
+}
+
+func validateInputFunc(service *protogen.Service) string {
+	return "validate" + service.GoName + "Input"
+}
+
+func anyInputConstraints(service *protogen.Service) bool {
+	for _, method := range service.Methods {
+		if hasInputConstraints(method) {
+			return true
+		}
+	}
+	return false
+}
+
+func anyWorkflowInputConstraints(service *protogen.Service) bool {
+	for _, method := range service.Methods {
+		if isWorkflow(method) && hasInputConstraints(method) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasInputConstraints(method *protogen.Method) bool {
+	return hasConstraints(method.Input, map[protoreflect.FullName]bool{})
+}
+
+// hasConstraints reports whether the given message has protovalidate
+// constraints, or any of its fields, oneofs, or nested messages.
+func hasConstraints(m *protogen.Message, seen map[protoreflect.FullName]bool) bool {
+	if seen[m.Desc.FullName()] {
+		return false
+	}
+	seen[m.Desc.FullName()] = true
+
+	if hasConstraintsOption(m.Desc.Options()) {
+		return true
+	}
+	for _, o := range m.Oneofs {
+		if hasConstraintsOption(o.Desc.Options()) {
+			return true
+		}
+	}
+	for _, f := range m.Fields {
+		if hasConstraintsOption(f.Desc.Options()) {
+			return true
+		}
+		if f.Message != nil && hasConstraints(f.Message, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasConstraintsOption(opts proto.Message) bool {
+	m := opts.ProtoReflect()
+	found := false
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		found = fd.IsExtension() && fd.Number() == protovalidateExtensionNumber &&
+			strings.HasPrefix(string(fd.FullName()), "buf.validate.")
+		return !found
+	})
+	if found {
+		return true
+	}
+
+	b := m.GetUnknown()
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return false
+		}
+		if num == protovalidateExtensionNumber {
+			return true
+		}
+		b = b[n:]
+		if n = protowire.ConsumeFieldValue(num, typ, b); n < 0 {
+			return false
+		}
+		b = b[n:]
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/daabr/protoc-gen-temporal-go/sensitive"
+)
+
+// ValidateSensitiveFields reports fields in the given file which are marked
+// as sensitive, but can't be encrypted: only string and bytes fields (which
+// may be repeated, but not maps) are supported.
+func ValidateSensitiveFields(f *protogen.File) error {
+	return validateSensitiveFields(f.Desc.Path(), f.Messages)
+}
+
+func validateSensitiveFields(path string, messages []*protogen.Message) error {
+	for _, m := range messages {
+		for _, field := range m.Fields {
+			if !sensitive.IsSensitive(field.Desc) {
+				continue
+			}
+			kind := field.Desc.Kind()
+			if field.Desc.IsMap() || (kind != protoreflect.StringKind && kind != protoreflect.BytesKind) {
+				return fmt.Errorf("%s: field %s is marked as sensitive, but only string and bytes fields are supported",
+					path, field.Desc.FullName())
+			}
+		}
+		if err := validateSensitiveFields(path, m.Messages); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// usesSensitiveFields reports whether the inputs or outputs of the methods
+// in the given service have sensitive fields, directly or in nested messages.
+func usesSensitiveFields(service *protogen.Service) bool {
+	seen := map[protoreflect.FullName]bool{}
+	for _, method := range service.Methods {
+		if hasSensitiveFields(method.Input, seen) || hasSensitiveFields(method.Output, seen) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasSensitiveFields(m *protogen.Message, seen map[protoreflect.FullName]bool) bool {
+	if seen[m.Desc.FullName()] {
+		return false
+	}
+	seen[m.Desc.FullName()] = true
+	for _, field := range m.Fields {
+		if sensitive.IsSensitive(field.Desc) {
+			return true
+		}
+		if field.Message != nil && hasSensitiveFields(field.Message, seen) {
+			return true
+		}
+	}
+	return false
+}
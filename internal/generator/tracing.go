@@ -0,0 +1,92 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+// GenerateTracing generates OpenTelemetry span descriptors for the methods
+// of the given service, if it opts into instrumentation.
+func GenerateTracing(g *protogen.GeneratedFile, service *protogen.Service) {
+	if !usesTracing(service) {
+		return
+	}
+	for _, method := range service.Methods {
+		g.P("// ", tracingVar(method), " describes the spans of ", method.GoName, ".")
+		g.P("var ", tracingVar(method), " = ", tracingPackage.Ident("Method"), "{")
+		g.P("FullName: ", strconv.Quote(string(method.Desc.FullName())), ",")
+		g.P("Service: ", strconv.Quote(string(service.Desc.FullName())), ",")
+		if tq := methodTaskQueue(method); tq != nil && *tq != "" {
+			g.P("TaskQueue: ", strconv.Quote(*tq), ",")
+		}
+		g.P("InputType: ", strconv.Quote(string(method.Input.Desc.FullName())), ",")
+		g.P("OutputType: ", strconv.Quote(string(method.Output.Desc.FullName())), ",")
+		g.P("}")
+		g.P()
+	}
+}
+
+// startSpan generates the start of a span in a generated function, if the
+// method's service opts into instrumentation, and reports whether it did.
+func startSpan(g *protogen.GeneratedFile, method *protogen.Method, kind string) bool {
+	if !usesTracing(method.Parent) {
+		return false
+	}
+	g.P("ctx, span := ", tracingVar(method), ".Start(ctx, ", tracePackage.Ident(kind), ")")
+	return true
+}
+
+func endSpan(g *protogen.GeneratedFile, err string) {
+	g.P(tracingPackage.Ident("End"), "(span, ", err, ")")
+}
+
+func setSpanWorkflowID(g *protogen.GeneratedFile, id ...interface{}) {
+	args := append([]interface{}{tracingPackage.Ident("SetWorkflowID"), "(span, "}, id...)
+	g.P(append(args, ")")...)
+}
+
+// methodTaskQueue returns the task queue of the given method, or nil if it
+// can't be determined statically.
+func methodTaskQueue(method *protogen.Method) *string {
+	if isWorkflow(method) {
+		return taskQueue(method, workflowOptions(method).TaskQueue)
+	}
+	return taskQueue(method, activityOptions(method).TaskQueue)
+}
+
+func usesTracing(service *protogen.Service) bool {
+	w := proto.GetExtension(service.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker)
+	return w.GetTracing()
+}
+
+func tracingVar(method *protogen.Method) string {
+	return unexport(method.Parent.GoName) + method.GoName + "Tracing"
+}
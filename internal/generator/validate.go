@@ -0,0 +1,205 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+// ValidateService reports the first reason why the code generated for the
+// given service would be incorrect. Services without any Temporal extension
+// are only checked for retry policy references in their default options,
+// since they aren't meant to be used with Temporal.
+func ValidateService(service *protogen.Service, idx *Index) error {
+	// Default options of the file apply to all its services, including
+	// services without any Temporal extension.
+	for _, method := range service.Methods {
+		if err := validateRetryPolicyRef(method, idx); err != nil {
+			return err
+		}
+	}
+	if !isAnnotated(service) {
+		return nil
+	}
+	if err := validateBuildID(service); err != nil {
+		return err
+	}
+	if err := validateLargePayloadThreshold(service); err != nil {
+		return err
+	}
+	for _, method := range service.Methods {
+		if err := validateStreaming(method); err != nil {
+			return err
+		}
+		if _, err := idx.heartbeatDetails(method); err != nil {
+			return err
+		}
+		if err := validateErrorTypes(method, idx); err != nil {
+			return err
+		}
+		if err := validateVersionChanges(method); err != nil {
+			return err
+		}
+		if _, err := idx.calls(method); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isAnnotated(service *protogen.Service) bool {
+	if proto.HasExtension(service.Desc.Options(), workerpb.E_Worker) {
+		return true
+	}
+	for _, method := range service.Methods {
+		if isWorkflow(method) || proto.HasExtension(method.Desc.Options(), workerpb.E_Activity) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateStreaming rejects streaming RPCs: Temporal workflows and activities
+// receive a single input and return a single output.
+func validateStreaming(method *protogen.Method) error {
+	var kind string
+	switch {
+	case method.Desc.IsStreamingClient() && method.Desc.IsStreamingServer():
+		kind = "bidirectional-streaming"
+	case method.Desc.IsStreamingClient():
+		kind = "client-streaming"
+	case method.Desc.IsStreamingServer():
+		kind = "server-streaming"
+	default:
+		return nil
+	}
+	return fmt.Errorf("%s: %s rpc %s is not supported: Temporal workflows and activities "+
+		"have a single input and a single output (consider signals and queries to stream data)",
+		method.Desc.ParentFile().Path(), kind, method.Desc.FullName())
+}
+
+// validateRetryPolicyRef reports a reference to a retry policy preset in the
+// effective options of the given method which can't be resolved.
+func validateRetryPolicyRef(method *protogen.Method, idx *Index) error {
+	ref := activityOptions(method).GetRetryPolicyRef()
+	if isWorkflow(method) {
+		ref = workflowOptions(method).GetRetryPolicyRef()
+	}
+	if ref == "" {
+		return nil
+	}
+	_, _, err := idx.resolveRetryPolicy(method, ref)
+	return err
+}
+
+// ValidateTaskQueues reports services (in all the given files, including
+// imported ones) which share the same task queue but not the same worker
+// options. A task queue must be polled by workers which host all the
+// workflows and activities that are scheduled on it, so such services
+// must share a single worker, which can have only one set of options, and
+// only one client (i.e. one payload encoding and large payload threshold).
+// A worker also can't register two workflows or two activities with the
+// same name.
+func ValidateTaskQueues(files []*protogen.File) error {
+	type owner struct {
+		service   *protogen.Service
+		options   *workerpb.WorkerOptions
+		encoding  workerpb.PayloadEncoding
+		largeSize int64
+	}
+	owners := map[string]owner{}
+	registered := map[string]*protogen.Service{} // Keyed by task queue, kind and name.
+	for _, f := range files {
+		for _, service := range f.Services {
+			w := proto.GetExtension(service.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker)
+			if w.GetTaskQueue() == "" {
+				continue
+			}
+			for _, method := range service.Methods {
+				kind := "an activity"
+				if isWorkflow(method) {
+					kind = "a workflow"
+				}
+				key := w.TaskQueue + "/" + kind + "/" + method.GoName
+				if other, ok := registered[key]; ok {
+					return fmt.Errorf("%s: service %s shares the task queue %q with service %s (in %s), "+
+						"but they both have %s named %q", f.Desc.Path(), service.Desc.FullName(),
+						w.TaskQueue, other.Desc.FullName(), other.Desc.ParentFile().Path(), kind, method.GoName)
+				}
+				registered[key] = service
+			}
+			opts := w.GetOptions()
+			if opts == nil {
+				opts = &workerpb.WorkerOptions{}
+			}
+			o, ok := owners[w.TaskQueue]
+			if !ok {
+				owners[w.TaskQueue] = owner{service, opts, w.PayloadEncoding, w.LargePayloadThreshold}
+				continue
+			}
+			if o.encoding != w.PayloadEncoding {
+				return fmt.Errorf("%s: service %s shares the task queue %q with service %s (in %s), "+
+					"but they have different payload encodings", f.Desc.Path(), service.Desc.FullName(),
+					w.TaskQueue, o.service.Desc.FullName(), o.service.Desc.ParentFile().Path())
+			}
+			if o.largeSize != w.LargePayloadThreshold {
+				return fmt.Errorf("%s: service %s shares the task queue %q with service %s (in %s), "+
+					"but they have different large payload thresholds", f.Desc.Path(), service.Desc.FullName(),
+					w.TaskQueue, o.service.Desc.FullName(), o.service.Desc.ParentFile().Path())
+			}
+			if !proto.Equal(o.options, opts) {
+				return fmt.Errorf("%s: service %s shares the task queue %q with service %s (in %s), "+
+					"but they have different worker options", f.Desc.Path(), service.Desc.FullName(),
+					w.TaskQueue, o.service.Desc.FullName(), o.service.Desc.ParentFile().Path())
+			}
+		}
+	}
+	return nil
+}
+
+// validateLargePayloadThreshold reports a negative large payload threshold.
+func validateLargePayloadThreshold(service *protogen.Service) error {
+	w := proto.GetExtension(service.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker)
+	if w.GetLargePayloadThreshold() < 0 {
+		return fmt.Errorf("%s: service %s specifies a negative large payload threshold",
+			service.Desc.ParentFile().Path(), service.Desc.FullName())
+	}
+	return nil
+}
+
+// validateBuildID reports a compatible build ID without a build ID.
+func validateBuildID(service *protogen.Service) error {
+	w := proto.GetExtension(service.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker)
+	if w.GetOptions().GetCompatibleBuildId() != "" && w.GetOptions().GetBuildId() == "" {
+		return fmt.Errorf("%s: service %s specifies a compatible build ID without a build ID",
+			service.Desc.ParentFile().Path(), service.Desc.FullName())
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package generator
+
+import (
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+// defaultVersion is the value of workflow.DefaultVersion.
+const defaultVersion = -1
+
+// GenerateVersionChanges generates typed constants and GetVersion helpers
+// for the declared version changes of all the workflows in the given service.
+func GenerateVersionChanges(g *protogen.GeneratedFile, service *protogen.Service, cfg *Config) {
+	prefix := cfg.helperPrefix(service.GoName)
+	for _, method := range service.Methods {
+		for _, c := range versionChanges(method) {
+			if !c.Removed {
+				versionChange(g, method, prefix+method.GoName, c)
+			}
+		}
+	}
+}
+
+func versionChange(g *protogen.GeneratedFile, method *protogen.Method, workflowName string, c *workerpb.VersionChange) {
+	change := workflowName + "Change" + goCamelCase(c.ChangeId)
+	version := workflowName + "Version" + goCamelCase(c.ChangeId)
+
+	g.P("// ", change, " is the ID of the ", strconv.Quote(c.ChangeId), " change in")
+	g.P("// the ", method.GoName, " workflow.")
+	if c.Description != "" {
+		g.P("//")
+		g.P("// ", c.Description)
+	}
+	g.P("const ", change, " = ", strconv.Quote(c.ChangeId))
+	g.P()
+
+	versionType := g.QualifiedGoIdent(workflowPackage.Ident("Version"))
+	g.P("// Min and max supported versions of ", change, ".")
+	g.P("const (")
+	if c.MinSupportedVersion == nil {
+		g.P(version, "Min ", versionType, " = ", workflowPackage.Ident("DefaultVersion"))
+	} else {
+		g.P(version, "Min ", versionType, " = ", c.GetMinSupportedVersion())
+	}
+	g.P(version, "Max ", versionType, " = ", c.MaxSupportedVersion)
+	g.P(")")
+	g.P()
+
+	g.P("// ", version, " returns the version of the ", strconv.Quote(c.ChangeId), " change")
+	g.P("// which the current run of the ", method.GoName, " workflow should execute. For")
+	g.P("// more information, see https://docs.temporal.io/dev-guide/go/versioning#workflow-versioning.")
+	g.P("func ", version, "(ctx ", workflowPackage.Ident("Context"), ") ", versionType, " {")
+	g.P("return ", workflowPackage.Ident("GetVersion"), "(ctx, ", change, ", ", version, "Min, ", version, "Max)")
+	g.P("}")
+	g.P()
+}
+
+// versionChanges returns the version changes which the given method declares,
+// if it's a workflow.
+func versionChanges(method *protogen.Method) []*workerpb.VersionChange {
+	w := proto.GetExtension(method.Desc.Options(), workerpb.E_Workflow).(*workerpb.Workflow)
+	return w.GetVersionChanges()
+}
+
+// validateVersionChanges reports invalid or duplicate version changes in the
+// given method (including different IDs with the same Go name), and changes
+// which were removed prematurely.
+func validateVersionChanges(method *protogen.Method) error {
+	path := method.Desc.ParentFile().Path()
+	seen := map[string]string{} // Change IDs keyed by their Go names.
+	for _, c := range versionChanges(method) {
+		if !identifierRegexp.MatchString(c.ChangeId) {
+			return fmt.Errorf("%s: workflow %s declares an invalid version change ID %q",
+				path, method.Desc.FullName(), c.ChangeId)
+		}
+		name := goCamelCase(c.ChangeId)
+		if other, ok := seen[name]; ok {
+			if other == c.ChangeId {
+				return fmt.Errorf("%s: workflow %s declares version change %q more than once",
+					path, method.Desc.FullName(), c.ChangeId)
+			}
+			return fmt.Errorf("%s: workflow %s declares version changes %q and %q, which have the same Go name %q",
+				path, method.Desc.FullName(), other, c.ChangeId, name)
+		}
+		seen[name] = c.ChangeId
+
+		min := int32(defaultVersion)
+		if c.MinSupportedVersion != nil {
+			min = c.GetMinSupportedVersion()
+		}
+		if min < defaultVersion || c.MaxSupportedVersion < min {
+			return fmt.Errorf("%s: workflow %s declares version change %q with an invalid range [%d, %d]",
+				path, method.Desc.FullName(), c.ChangeId, min, c.MaxSupportedVersion)
+		}
+		if c.Removed && min > defaultVersion {
+			return fmt.Errorf("%s: workflow %s removes version change %q while its min supported version (%d) "+
+				"is still above workflow.DefaultVersion", path, method.Desc.FullName(), c.ChangeId, min)
+		}
+	}
+	return nil
+}
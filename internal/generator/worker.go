@@ -25,13 +25,19 @@ SOFTWARE.
 package generator
 
 import (
+	"strconv"
+
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/types/known/durationpb"
 
 	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
 )
 
+const (
+	workerOptionSuffix = "WorkerOption"
+	taskQueueSuffix    = "TaskQueue"
+)
+
 func GenerateWorker(g *protogen.GeneratedFile, service *protogen.Service) {
 	worker := proto.GetExtension(service.Desc.Options(), workerpb.E_Worker).(*workerpb.Worker)
 	if worker == nil || worker.TaskQueue == "" {
@@ -39,18 +45,54 @@ func GenerateWorker(g *protogen.GeneratedFile, service *protogen.Service) {
 		return
 	}
 
-	g.P("func StartWorker", service.GoName, "(c ", clientPackage.Ident("Client"), ") {")
-	g.P(`taskQueue := "`, worker.TaskQueue, `"`)
+	optionType := service.GoName + workerOptionSuffix
+	workerRuntimeOptions(g, service, optionType)
+
+	taskQueue := service.GoName + taskQueueSuffix
+	g.P("// ", taskQueue, " is the name of the task queue of the ", service.GoName, " worker.")
+	g.P("const ", taskQueue, ` = "`, worker.TaskQueue, `"`)
+	g.P()
+
+	newWorker(g, service, worker, optionType, taskQueue)
+	registerWorker(g, service)
+	startWorker(g, service, optionType)
+	updateBuildIDCompatibility(g, service, worker.Options, taskQueue)
+}
+
+func newWorker(g *protogen.GeneratedFile, service *protogen.Service, worker *workerpb.Worker, optionType, taskQueue string) {
+	g.P("// NewWorker", service.GoName, " creates a worker for the task queue of ", service.GoName, ",")
+	g.P("// with the worker options of its proto definition. The worker may also host")
+	g.P("// other services which share the same task queue, see Register", service.GoName, ".")
+	g.P("func NewWorker", service.GoName, "(c ", clientPackage.Ident("Client"), ", runtimeOpts ...", optionType, ") ", workerPackage.Ident("Worker"), " {")
 	g.P("opts := ", workerPackage.Ident("Options"), "{")
 	if worker.Options != nil {
 		nonDefaultWorkerOptions(g, worker.Options)
 	}
 	g.P("}")
-
-	g.P("w := ", workerPackage.Ident("New"), "(c, taskQueue, opts)")
+	g.P("for _, o := range runtimeOpts {")
+	g.P("o(&opts)")
+	g.P("}")
+	g.P("return ", workerPackage.Ident("New"), "(c, ", taskQueue, ", opts)")
+	g.P("}")
 	g.P()
+}
 
+func registerWorker(g *protogen.GeneratedFile, service *protogen.Service) {
+	g.P("// Register", service.GoName, " registers the workflows and activities of ", service.GoName)
+	g.P("// in the given worker, which may be shared with other services that have the")
+	g.P("// same task queue (and therefore, the same worker options).")
+	g.P("func Register", service.GoName, "(w ", workerPackage.Ident("Registry"), ", impl ", service.GoName+interfaceSuffix, ") {")
 	registerWorkerMethods(g, service.Methods)
+	g.P("}")
+	g.P()
+}
+
+func startWorker(g *protogen.GeneratedFile, service *protogen.Service, optionType string) {
+	g.P("// StartWorker", service.GoName, " runs a worker which hosts only ", service.GoName, ",")
+	g.P("// until the process receives an interrupt signal.")
+	g.P("func StartWorker", service.GoName, "(c ", clientPackage.Ident("Client"), ", impl ", service.GoName+interfaceSuffix, ", runtimeOpts ...", optionType, ") {")
+	g.P("w := NewWorker", service.GoName, "(c, runtimeOpts...)")
+	g.P("Register", service.GoName, "(w, impl)")
 	g.P()
 
 	g.P("if err := w.Run(", workerPackage.Ident("InterruptCh"), "()); err != nil {")
@@ -61,11 +103,100 @@ func GenerateWorker(g *protogen.GeneratedFile, service *protogen.Service) {
 	g.P()
 }
 
-func nonDefaultWorkerOptions(g *protogen.GeneratedFile, o *workerpb.WorkerOptions) {
+// updateBuildIDCompatibility generates a helper which registers the worker's
+// build ID in the server, if the worker options specify one.
+func updateBuildIDCompatibility(g *protogen.GeneratedFile, service *protogen.Service, o *workerpb.WorkerOptions, taskQueue string) {
+	if o.GetBuildId() == "" {
+		return
+	}
+
+	name := "UpdateBuildIDCompatibility" + service.GoName
+	g.P("// ", name, " registers the build ID of the ", service.GoName, " worker")
+	if o.CompatibleBuildId == "" {
+		g.P("// (", strconv.Quote(o.BuildId), ") as the default build ID of its task queue, in a new set of")
+		g.P("// compatible build IDs. Call it before starting workers with this build ID.")
+	} else {
+		g.P("// (", strconv.Quote(o.BuildId), ") as compatible with its predecessor (", strconv.Quote(o.CompatibleBuildId), "),")
+		g.P("// in the task queue of the worker. Call it before starting workers with this build ID.")
+	}
+	g.P("// For more information, see https://docs.temporal.io/workers#worker-versioning.")
+	g.P("func ", name, "(ctx ", contextPackage.Ident("Context"), ", c ", clientPackage.Ident("Client"), ") error {")
+	g.P("return c.UpdateWorkerBuildIdCompatibility(ctx, &", clientPackage.Ident("UpdateWorkerBuildIdCompatibilityOptions"), "{")
+	g.P("TaskQueue: ", taskQueue, ",")
+	if o.CompatibleBuildId == "" {
+		g.P("Operation: &", clientPackage.Ident("BuildIDOpAddNewIDInNewDefaultSet"), "{")
+		g.P("BuildID: ", strconv.Quote(o.BuildId), ",")
+	} else {
+		g.P("Operation: &", clientPackage.Ident("BuildIDOpAddNewCompatibleVersion"), "{")
+		g.P("BuildID: ", strconv.Quote(o.BuildId), ",")
+		g.P("ExistingCompatibleBuildId: ", strconv.Quote(o.CompatibleBuildId), ",")
+	}
+	g.P("},")
+	g.P("})")
+	g.P("}")
+	g.P()
+}
+
+// workerRuntimeOptions generates a functional option type for the worker of
+// the given service, and constructors for it, to set [worker.Options] fields
+// that can't be expressed in proto files (e.g. contexts and callbacks).
+func workerRuntimeOptions(g *protogen.GeneratedFile, service *protogen.Service, optionType string) {
+	g.P("// ", optionType, " sets runtime-only worker options, which")
+	g.P("// complement the options in the service's proto definition.")
+	g.P("type ", optionType, " func(*", workerPackage.Ident("Options"), ")")
+	g.P()
+
 	options := []struct {
-		value  interface{}
-		goName string
+		goName    string
+		param     string
+		paramType string
+		comment   []string
 	}{
+		{
+			"BackgroundActivityContext",
+			"ctx",
+			g.QualifiedGoIdent(contextPackage.Ident("Context")),
+			[]string{
+				"sets the context which activities can",
+				"use to access resources which are shared by all the activities in the worker.",
+			},
+		},
+		{
+			"Interceptors",
+			"interceptors",
+			"..." + g.QualifiedGoIdent(interceptorPackage.Ident("WorkerInterceptor")),
+			[]string{
+				"sets the worker interceptors to apply,",
+				"in addition to the interceptors of the client.",
+			},
+		},
+		{
+			"OnFatalError",
+			"f",
+			"func(error)",
+			[]string{
+				"sets a callback which is invoked when",
+				"the worker encounters an unrecoverable error and stops.",
+			},
+		},
+	}
+	for _, o := range options {
+		name := "With" + service.GoName + o.goName
+		g.P("// ", name, " ", o.comment[0])
+		for _, line := range o.comment[1:] {
+			g.P("// ", line)
+		}
+		g.P("func ", name, "(", o.param, " ", o.paramType, ") ", optionType, " {")
+		g.P("return func(o *", workerPackage.Ident("Options"), ") {")
+		g.P("o.", o.goName, " = ", o.param)
+		g.P("}")
+		g.P("}")
+		g.P()
+	}
+}
+
+func nonDefaultWorkerOptions(g *protogen.GeneratedFile, o *workerpb.WorkerOptions) {
+	options := []option{
 		{
 			o.MaxConcurrentActivityExecutionSize,
 			"MaxConcurrentActivityExecutionSize",
@@ -107,8 +238,11 @@ func nonDefaultWorkerOptions(g *protogen.GeneratedFile, o *workerpb.WorkerOption
 			o.StickyScheduleToStartTimeout,
 			"StickyScheduleToStartTimeout",
 		},
-		// TODO: BackgroundActivityContext
-		// TODO: WorkflowPanicPolicy
+		// BackgroundActivityContext: see workerRuntimeOptions.
+		{
+			o.WorkflowPanicPolicy,
+			"WorkflowPanicPolicy",
+		},
 		{
 			o.WorkerStopTimeout,
 			"WorkerStopTimeout",
@@ -145,8 +279,7 @@ func nonDefaultWorkerOptions(g *protogen.GeneratedFile, o *workerpb.WorkerOption
 			o.DefaultHeartbeatThrottleInterval,
 			"DefaultHeartbeatThrottleInterval",
 		},
-		// TODO: Interceptors []WorkerInterceptor
-		// TODO: OnFatalError func(error)
+		// Interceptors, OnFatalError: see workerRuntimeOptions.
 		{
 			o.DisableEagerActivities,
 			"DisableEagerActivities",
@@ -168,38 +301,54 @@ func nonDefaultWorkerOptions(g *protogen.GeneratedFile, o *workerpb.WorkerOption
 			"UseBuildIDForVersioning",
 		},
 	}
-	for _, option := range options {
-		if v, ok := option.value.(bool); ok && v {
-			g.P(option.goName, ": ", v, ",")
-			continue
-		}
-		if v, ok := option.value.(float64); ok && v != 0 {
-			g.P(option.goName, ": ", v, ",")
-			continue
-		}
-		if v, ok := option.value.(int32); ok && v != 0 {
-			g.P(option.goName, ": ", v, ",")
-			continue
-		}
-		if v, ok := option.value.(string); ok && v != "" {
-			g.P(option.goName, `: "`, v, `",`)
-			continue
-		}
-		if v, ok := option.value.(*durationpb.Duration); ok && v != nil {
-			s := v.AsDuration().Seconds()
-			g.P(option.goName, ": ", timePackage.Ident("Duration"), "(", s, " * float64(time.Second)),")
-			continue
-		}
-	}
+	nonDefaultOptions(g, options)
 }
 
 func registerWorkerMethods(g *protogen.GeneratedFile, methods []*protogen.Method) {
 	for _, m := range methods {
+		if hasInputConstraints(m) || usesMetrics(m.Parent) || (usesTracing(m.Parent) && !isWorkflow(m)) {
+			registerWrappedMethod(g, m)
+			continue
+		}
 		w := proto.GetExtension(m.Desc.Options(), workerpb.E_Workflow).(*workerpb.Workflow)
 		if w != nil {
-			g.P("w.RegisterWorkflow(", m.GoName, ")")
+			g.P("w.RegisterWorkflow(impl.", m.GoName, ")")
 		} else {
-			g.P("w.RegisterActivity(", m.GoName, ")")
+			g.P("w.RegisterActivity(impl.", m.GoName, ")")
 		}
 	}
 }
+
+// registerWrappedMethod registers a method with a wrapper that validates its
+// input (if it has protovalidate constraints) and instruments it (if its
+// service opts into tracing or metrics), under the same name that Temporal
+// would derive from the method itself.
+func registerWrappedMethod(g *protogen.GeneratedFile, m *protogen.Method) {
+	p, register := contextPackage, "RegisterActivityWithOptions"
+	options := activityPackage.Ident("RegisterOptions")
+	if isWorkflow(m) {
+		p, register = workflowPackage, "RegisterWorkflowWithOptions"
+		options = workflowPackage.Ident("RegisterOptions")
+	}
+
+	ctx := g.QualifiedGoIdent(p.Ident("Context"))
+	g.P("w.", register, "(func(ctx ", ctx, inputParam(g, m), ") ", outputResult(g, m), " {")
+	validateInput(g, m, errorReturn(m))
+	call := "impl." + m.GoName + "(ctx" + inputArg(m) + ")"
+	i := workerInstrumentation(g, m)
+	switch {
+	case !i.enabled():
+		g.P("return ", call)
+	case isEmpty(m.Output):
+		i.setWorkflowID(g, activityPackage.Ident("GetInfo"), "(ctx).WorkflowExecution.ID")
+		g.P("err := ", call)
+		i.end(g, "err")
+		g.P("return err")
+	default:
+		i.setWorkflowID(g, activityPackage.Ident("GetInfo"), "(ctx).WorkflowExecution.ID")
+		g.P("out, err := ", call)
+		i.end(g, "err")
+		g.P("return out, err")
+	}
+	g.P("}, ", options, `{Name: "`, m.GoName, `"})`)
+}
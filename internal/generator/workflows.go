@@ -30,56 +30,90 @@ import (
 	"google.golang.org/protobuf/compiler/protogen"
 )
 
-func startWorkflow(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string) {
+func startWorkflow(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string, idx *Index) {
 	comment := []string{
 		"This method starts the workflow with pre-configured options, and returns a",
 		"WorkflowRun to interact with it until completion. For more information, see",
 		"https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.",
 	}
 	ctx := g.QualifiedGoIdent(contextPackage.Ident("Context"))
-	in := g.QualifiedGoIdent(method.Input.GoIdent)
+	in := inputParam(g, method)
 	out := fmt.Sprintf("(%s, error)", g.QualifiedGoIdent(clientPackage.Ident("WorkflowRun")))
 
 	executePrefix(g, method, comment, structName, "StartWorkflow", serviceName, ctx, in, out)
+	validateInput(g, method, "return nil, err")
 
 	g.P("opts := ", clientPackage.Ident("StartWorkflowOptions"), "{")
-	nonDefaultStartWorkflowOptions(g, method)
+	nonDefaultStartWorkflowOptions(g, method, idx)
 	g.P("}")
 
-	g.P("return ", "c.t.ExecuteWorkflow", "(ctx, opts, c.", method.GoName, ", in)")
+	i := clientInstrumentation(g, method)
+	if !i.enabled() {
+		g.P("return ", "c.t.ExecuteWorkflow", "(ctx, opts, c.", method.GoName, inputArg(method), ")")
+		g.P("}")
+		g.P()
+		return
+	}
+
+	g.P("run, err := ", "c.t.ExecuteWorkflow", "(ctx, opts, c.", method.GoName, inputArg(method), ")")
+	if i.tracing {
+		g.P("if err == nil {")
+		i.setWorkflowID(g, "run.GetID()")
+		g.P("}")
+	}
+	i.end(g, "err")
+	g.P("return run, err")
 	g.P("}")
 	g.P()
 }
 
-func executeWorkflow(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string) {
+func executeWorkflow(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string, idx *Index) {
 	comment := []string{
 		"This method executes the workflow with pre-configured options, blocks until",
 		"completion, and returns the output/error results. For more information, see",
 		"https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.",
 	}
 	ctx := g.QualifiedGoIdent(contextPackage.Ident("Context"))
-	in := g.QualifiedGoIdent(method.Input.GoIdent)
-	out := fmt.Sprintf("(*%s, error)", g.QualifiedGoIdent(method.Output.GoIdent))
+	in := inputParam(g, method)
+	out := outputResult(g, method)
 
 	executePrefix(g, method, comment, structName, "ExecuteWorkflow", serviceName, ctx, in, out)
+	validateInput(g, method, errorReturn(method))
 
 	g.P("opts := ", clientPackage.Ident("StartWorkflowOptions"), "{")
-	nonDefaultStartWorkflowOptions(g, method)
+	nonDefaultStartWorkflowOptions(g, method, idx)
 	g.P("}")
 
-	g.P("run, err := ", "c.t.ExecuteWorkflow", "(ctx, opts, c.", method.GoName, ", in)")
+	i := clientInstrumentation(g, method)
+	g.P("run, err := ", "c.t.ExecuteWorkflow", "(ctx, opts, c.", method.GoName, inputArg(method), ")")
 	g.P("if err != nil {")
-	g.P("return nil, err")
+	i.end(g, "err")
+	if isEmpty(method.Output) {
+		g.P("return err")
+	} else {
+		g.P("return nil, err")
+	}
 	g.P("}")
-
-	g.P("var out *", g.QualifiedGoIdent(method.Output.GoIdent))
-	g.P("err = run.Get(ctx, &out)")
-	g.P("return out, err")
+	i.setWorkflowID(g, "run.GetID()")
+
+	switch {
+	case isEmpty(method.Output) && !i.enabled():
+		g.P("return run.Get(ctx, nil)")
+	case isEmpty(method.Output):
+		g.P("err = run.Get(ctx, nil)")
+		i.end(g, "err")
+		g.P("return err")
+	default:
+		g.P("var out *", g.QualifiedGoIdent(method.Output.GoIdent))
+		g.P("err = run.Get(ctx, &out)")
+		i.end(g, "err")
+		g.P("return out, err")
+	}
 	g.P("}")
 	g.P()
 }
 
-func startChildWorkflow(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string) {
+func startChildWorkflow(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string, idx *Index) {
 	comment := []string{
 		"This method starts the workflow (as a child) with pre-configured options,",
 		"and returns a Future to interact with it until completion. For more info,",
@@ -87,21 +121,22 @@ func startChildWorkflow(g *protogen.GeneratedFile, method *protogen.Method, stru
 		"and https://docs.temporal.io/workflows#child-workflow.",
 	}
 	ctx := g.QualifiedGoIdent(workflowPackage.Ident("Context"))
-	in := g.QualifiedGoIdent(method.Input.GoIdent)
+	in := inputParam(g, method)
 	out := g.QualifiedGoIdent(workflowPackage.Ident("ChildWorkflowFuture"))
 
 	executePrefix(g, method, comment, structName, "StartChildWorkflow", serviceName, ctx, in, out)
+	validateInput(g, method, failedChildFuture(g, method.Parent)...)
 
 	g.P("ctx = ", workflowPackage.Ident("WithChildOptions"), "(ctx, ", workflowPackage.Ident("ChildWorkflowOptions"), "{")
-	nonDefaultChildWorkflowOptions(g, method)
+	nonDefaultChildWorkflowOptions(g, method, idx)
 	g.P("})")
 
-	g.P("return ", workflowPackage.Ident("ExecuteChildWorkflow"), "(ctx, c.", method.GoName, ", in)")
+	g.P("return ", workflowPackage.Ident("ExecuteChildWorkflow"), "(ctx, c.", method.GoName, inputArg(method), ")")
 	g.P("}")
 	g.P()
 }
 
-func executeChildWorkflow(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string) {
+func executeChildWorkflow(g *protogen.GeneratedFile, method *protogen.Method, structName, serviceName string, idx *Index) {
 	comment := []string{
 		"This method executes the workflow (as a child) with pre-configured options,",
 		"blocks until completion, and returns the output/error. For more information,",
@@ -109,26 +144,103 @@ func executeChildWorkflow(g *protogen.GeneratedFile, method *protogen.Method, st
 		"and https://docs.temporal.io/workflows#child-workflow.",
 	}
 	ctx := g.QualifiedGoIdent(workflowPackage.Ident("Context"))
-	in := g.QualifiedGoIdent(method.Input.GoIdent)
-	out := fmt.Sprintf("(*%s, error)", g.QualifiedGoIdent(method.Output.GoIdent))
+	in := inputParam(g, method)
+	out := outputResult(g, method)
 
 	executePrefix(g, method, comment, structName, "ExecuteChildWorkflow", serviceName, ctx, in, out)
+	validateInput(g, method, errorReturn(method))
 
 	g.P("ctx = ", workflowPackage.Ident("WithChildOptions"), "(ctx, ", workflowPackage.Ident("ChildWorkflowOptions"), "{")
-	nonDefaultChildWorkflowOptions(g, method)
+	nonDefaultChildWorkflowOptions(g, method, idx)
 	g.P("})")
 
-	g.P("var out *", g.QualifiedGoIdent(method.Output.GoIdent))
-	g.P("err := ", workflowPackage.Ident("ExecuteChildWorkflow"), "(ctx, c.", method.GoName, ", in).Get(ctx, &out)")
-	g.P("return out, err")
+	getOutput(g, method, g.QualifiedGoIdent(workflowPackage.Ident("ExecuteChildWorkflow"))+"(ctx, c."+method.GoName+inputArg(method)+")")
 	g.P("}")
 	g.P()
 }
 
-func nonDefaultStartWorkflowOptions(g *protogen.GeneratedFile, method *protogen.Method) {
-	// TODO
+func nonDefaultStartWorkflowOptions(g *protogen.GeneratedFile, method *protogen.Method, idx *Index) {
+	o := workflowOptions(method)
+	nonDefaultOptions(g, []option{
+		{
+			o.Id,
+			"ID",
+		},
+		{
+			taskQueue(method, o.TaskQueue),
+			"TaskQueue",
+		},
+		{
+			o.WorkflowExecutionTimeout,
+			"WorkflowExecutionTimeout",
+		},
+		{
+			o.WorkflowRunTimeout,
+			"WorkflowRunTimeout",
+		},
+		{
+			o.WorkflowTaskTimeout,
+			"WorkflowTaskTimeout",
+		},
+		{
+			o.WorkflowIdReusePolicy,
+			"WorkflowIDReusePolicy",
+		},
+		{
+			o.WorkflowExecutionErrorWhenAlreadyStarted,
+			"WorkflowExecutionErrorWhenAlreadyStarted",
+		},
+		{
+			idx.retryPolicyOption(method, o.GetRetryPolicyRef(), o.GetRetryPolicy()),
+			"RetryPolicy",
+		},
+		{
+			o.CronSchedule,
+			"CronSchedule",
+		},
+		// TODO: Memo
+		// TODO: SearchAttributes
+	})
 }
 
-func nonDefaultChildWorkflowOptions(g *protogen.GeneratedFile, method *protogen.Method) {
-	// TODO
+func nonDefaultChildWorkflowOptions(g *protogen.GeneratedFile, method *protogen.Method, idx *Index) {
+	o := workflowOptions(method)
+	nonDefaultOptions(g, []option{
+		{
+			o.Id,
+			"WorkflowID",
+		},
+		{
+			taskQueue(method, o.TaskQueue),
+			"TaskQueue",
+		},
+		{
+			o.WorkflowExecutionTimeout,
+			"WorkflowExecutionTimeout",
+		},
+		{
+			o.WorkflowRunTimeout,
+			"WorkflowRunTimeout",
+		},
+		{
+			o.WorkflowTaskTimeout,
+			"WorkflowTaskTimeout",
+		},
+		{
+			o.WorkflowIdReusePolicy,
+			"WorkflowIDReusePolicy",
+		},
+		{
+			idx.retryPolicyOption(method, o.GetRetryPolicyRef(), o.GetRetryPolicy()),
+			"RetryPolicy",
+		},
+		{
+			o.CronSchedule,
+			"CronSchedule",
+		},
+		{
+			o.VersioningIntent,
+			"VersioningIntent",
+		},
+	})
 }
@@ -47,6 +47,172 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// VersioningIntent represents https://pkg.go.dev/go.temporal.io/sdk/temporal#VersioningIntent.
+type VersioningIntent int32
+
+const (
+	// The SDK default: the command runs on a worker with a compatible
+	// build ID, if the target task queue is the same as the current one.
+	VersioningIntent_VERSIONING_INTENT_UNSPECIFIED VersioningIntent = 0
+	// The command should run on a worker with a build ID which is compatible
+	// with the build ID of the current worker.
+	VersioningIntent_VERSIONING_INTENT_COMPATIBLE VersioningIntent = 1
+	// The command should run on the default build ID of the target task
+	// queue, even if it's incompatible with the current worker.
+	VersioningIntent_VERSIONING_INTENT_DEFAULT VersioningIntent = 2
+)
+
+// Enum value maps for VersioningIntent.
+var (
+	VersioningIntent_name = map[int32]string{
+		0: "VERSIONING_INTENT_UNSPECIFIED",
+		1: "VERSIONING_INTENT_COMPATIBLE",
+		2: "VERSIONING_INTENT_DEFAULT",
+	}
+	VersioningIntent_value = map[string]int32{
+		"VERSIONING_INTENT_UNSPECIFIED": 0,
+		"VERSIONING_INTENT_COMPATIBLE":  1,
+		"VERSIONING_INTENT_DEFAULT":     2,
+	}
+)
+
+func (x VersioningIntent) Enum() *VersioningIntent {
+	p := new(VersioningIntent)
+	*p = x
+	return p
+}
+
+func (x VersioningIntent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VersioningIntent) Descriptor() protoreflect.EnumDescriptor {
+	return file_worker_proto_enumTypes[0].Descriptor()
+}
+
+func (VersioningIntent) Type() protoreflect.EnumType {
+	return &file_worker_proto_enumTypes[0]
+}
+
+func (x VersioningIntent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VersioningIntent.Descriptor instead.
+func (VersioningIntent) EnumDescriptor() ([]byte, []int) {
+	return file_worker_proto_rawDescGZIP(), []int{0}
+}
+
+// WorkflowPanicPolicy represents https://pkg.go.dev/go.temporal.io/sdk/worker#WorkflowPanicPolicy.
+type WorkflowPanicPolicy int32
+
+const (
+	// The SDK default, same as `WORKFLOW_PANIC_POLICY_BLOCK_WORKFLOW`.
+	WorkflowPanicPolicy_WORKFLOW_PANIC_POLICY_UNSPECIFIED WorkflowPanicPolicy = 0
+	// Blocks the workflow execution until the workflow code is fixed. The
+	// workflow task will be retried periodically, so it will resume when a
+	// worker with the fixed code is deployed. This is the default policy.
+	WorkflowPanicPolicy_WORKFLOW_PANIC_POLICY_BLOCK_WORKFLOW WorkflowPanicPolicy = 1
+	// Immediately fails the workflow execution when workflow code panics or
+	// detects non-determinism.
+	WorkflowPanicPolicy_WORKFLOW_PANIC_POLICY_FAIL_WORKFLOW WorkflowPanicPolicy = 2
+)
+
+// Enum value maps for WorkflowPanicPolicy.
+var (
+	WorkflowPanicPolicy_name = map[int32]string{
+		0: "WORKFLOW_PANIC_POLICY_UNSPECIFIED",
+		1: "WORKFLOW_PANIC_POLICY_BLOCK_WORKFLOW",
+		2: "WORKFLOW_PANIC_POLICY_FAIL_WORKFLOW",
+	}
+	WorkflowPanicPolicy_value = map[string]int32{
+		"WORKFLOW_PANIC_POLICY_UNSPECIFIED":    0,
+		"WORKFLOW_PANIC_POLICY_BLOCK_WORKFLOW": 1,
+		"WORKFLOW_PANIC_POLICY_FAIL_WORKFLOW":  2,
+	}
+)
+
+func (x WorkflowPanicPolicy) Enum() *WorkflowPanicPolicy {
+	p := new(WorkflowPanicPolicy)
+	*p = x
+	return p
+}
+
+func (x WorkflowPanicPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkflowPanicPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_worker_proto_enumTypes[1].Descriptor()
+}
+
+func (WorkflowPanicPolicy) Type() protoreflect.EnumType {
+	return &file_worker_proto_enumTypes[1]
+}
+
+func (x WorkflowPanicPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkflowPanicPolicy.Descriptor instead.
+func (WorkflowPanicPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_worker_proto_rawDescGZIP(), []int{1}
+}
+
+// PayloadEncoding represents the payload converters of proto messages in
+// https://pkg.go.dev/go.temporal.io/sdk/converter.
+type PayloadEncoding int32
+
+const (
+	// The SDK's default data converter, which encodes proto messages as JSON.
+	PayloadEncoding_PAYLOAD_ENCODING_UNSPECIFIED PayloadEncoding = 0
+	// Binary encoding ("binary/protobuf"), with ProtoPayloadConverter.
+	PayloadEncoding_PAYLOAD_ENCODING_BINARY PayloadEncoding = 1
+	// JSON encoding ("json/protobuf"), with ProtoJSONPayloadConverter.
+	PayloadEncoding_PAYLOAD_ENCODING_JSON PayloadEncoding = 2
+)
+
+// Enum value maps for PayloadEncoding.
+var (
+	PayloadEncoding_name = map[int32]string{
+		0: "PAYLOAD_ENCODING_UNSPECIFIED",
+		1: "PAYLOAD_ENCODING_BINARY",
+		2: "PAYLOAD_ENCODING_JSON",
+	}
+	PayloadEncoding_value = map[string]int32{
+		"PAYLOAD_ENCODING_UNSPECIFIED": 0,
+		"PAYLOAD_ENCODING_BINARY":      1,
+		"PAYLOAD_ENCODING_JSON":        2,
+	}
+)
+
+func (x PayloadEncoding) Enum() *PayloadEncoding {
+	p := new(PayloadEncoding)
+	*p = x
+	return p
+}
+
+func (x PayloadEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PayloadEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_worker_proto_enumTypes[2].Descriptor()
+}
+
+func (PayloadEncoding) Type() protoreflect.EnumType {
+	return &file_worker_proto_enumTypes[2]
+}
+
+func (x PayloadEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PayloadEncoding.Descriptor instead.
+func (PayloadEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_worker_proto_rawDescGZIP(), []int{2}
+}
+
 // WorkerOptions represents https://pkg.go.dev/go.temporal.io/sdk/worker#Options.
 // See also https://legacy-documentation-sdks.temporal.io/go/how-to-set-workeroptions-in-go.
 // TODO: Field comments.
@@ -147,6 +313,10 @@ type WorkerOptions struct {
 	//
 	// Optional: default = 5 seconds.
 	StickyScheduleToStartTimeout *durationpb.Duration `protobuf:"bytes,11,opt,name=sticky_schedule_to_start_timeout,json=stickyScheduleToStartTimeout,proto3" json:"sticky_schedule_to_start_timeout,omitempty"`
+	// Sets how the workflow worker should handle a panic from workflow code.
+	//
+	// Optional: default = `WORKFLOW_PANIC_POLICY_BLOCK_WORKFLOW`.
+	WorkflowPanicPolicy WorkflowPanicPolicy `protobuf:"varint,13,opt,name=workflow_panic_policy,json=workflowPanicPolicy,proto3,enum=temporal.WorkflowPanicPolicy" json:"workflow_panic_policy,omitempty"`
 	// The Worker's graceful stop timeout.
 	//
 	// Optional: default = 0 seconds.
@@ -164,6 +334,11 @@ type WorkerOptions struct {
 	DisableRegistrationAliasing             bool                 `protobuf:"varint,27,opt,name=disable_registration_aliasing,json=disableRegistrationAliasing,proto3" json:"disable_registration_aliasing,omitempty"`
 	BuildId                                 string               `protobuf:"bytes,28,opt,name=build_id,json=buildId,proto3" json:"build_id,omitempty"`
 	UseBuildIdForVersioning                 bool                 `protobuf:"varint,29,opt,name=use_build_id_for_versioning,json=useBuildIdForVersioning,proto3" json:"use_build_id_for_versioning,omitempty"`
+	// An existing build ID which build_id is compatible with. This isn't a
+	// worker option, but the generated UpdateBuildIDCompatibility helper
+	// uses it to add build_id to the compatible set of this build ID, instead
+	// of adding build_id as the default in a new set.
+	CompatibleBuildId string `protobuf:"bytes,30,opt,name=compatible_build_id,json=compatibleBuildId,proto3" json:"compatible_build_id,omitempty"`
 }
 
 func (x *WorkerOptions) Reset() {
@@ -276,6 +451,13 @@ func (x *WorkerOptions) GetStickyScheduleToStartTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *WorkerOptions) GetWorkflowPanicPolicy() WorkflowPanicPolicy {
+	if x != nil {
+		return x.WorkflowPanicPolicy
+	}
+	return WorkflowPanicPolicy_WORKFLOW_PANIC_POLICY_UNSPECIFIED
+}
+
 func (x *WorkerOptions) GetWorkerStopTimeout() *durationpb.Duration {
 	if x != nil {
 		return x.WorkerStopTimeout
@@ -374,8 +556,21 @@ func (x *WorkerOptions) GetUseBuildIdForVersioning() bool {
 	return false
 }
 
+func (x *WorkerOptions) GetCompatibleBuildId() string {
+	if x != nil {
+		return x.CompatibleBuildId
+	}
+	return ""
+}
+
 // StartWorkflowOptions represents https://pkg.go.dev/go.temporal.io/sdk/client#StartWorkflowOptions.
 // See also https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+//
+// Workflow options may be specified in 3 levels: file defaults, service
+// defaults, and method options. Each field which is present in a more specific
+// level replaces the same field in less specific levels. Message fields (e.g.
+// `retry_policy`) are replaced as a whole, not merged. Scalar fields are
+// `optional`, so they can also be overridden with zero values.
 type StartWorkflowOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -385,14 +580,14 @@ type StartWorkflowOptions struct {
 	//
 	// See https://docs.temporal.io/workflows#workflow-id.
 	// Optional: default = system generated UUID.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id *string `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
 	// Workflow tasks are scheduled on the queue with this name. This is also
 	// the name of the activity task queue on which activities are scheduled.
 	// The workflow author can choose to override this using activity options.
 	//
 	// See https://docs.temporal.io/tasks#task-queue.
 	// Required: no default.
-	TaskQueue string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueue *string `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3,oneof" json:"task_queue,omitempty"`
 	// The maximum and total amount of time that a Workflow Execution can
 	// be executing, including retries and any usage of Continue-As-New.
 	//
@@ -421,22 +616,18 @@ type StartWorkflowOptions struct {
 	// for dedupe logic if set to `WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE`.
 	//
 	// Optional: default = `WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE`.
-	WorkflowIdReusePolicy v1.WorkflowIdReusePolicy `protobuf:"varint,6,opt,name=workflow_id_reuse_policy,json=workflowIdReusePolicy,proto3,enum=temporal.api.enums.v1.WorkflowIdReusePolicy" json:"workflow_id_reuse_policy,omitempty"`
+	WorkflowIdReusePolicy *v1.WorkflowIdReusePolicy `protobuf:"varint,6,opt,name=workflow_id_reuse_policy,json=workflowIdReusePolicy,proto3,enum=temporal.api.enums.v1.WorkflowIdReusePolicy,oneof" json:"workflow_id_reuse_policy,omitempty"`
 	// When true, `Client.ExecuteWorkflow` will return an error if the workflow
 	// ID has already been used and [WorkflowIdReusePolicy] disallows re-runs.
 	// When false, rather than erroring, a `WorkflowRun` instance representing
 	// the current or last run will be returned.
 	//
 	// Optional: default = false.
-	WorkflowExecutionErrorWhenAlreadyStarted bool `protobuf:"varint,7,opt,name=workflow_execution_error_when_already_started,json=workflowExecutionErrorWhenAlreadyStarted,proto3" json:"workflow_execution_error_when_already_started,omitempty"`
-	// Typically used in activities rather than workflows, but if a retry
-	// policy is specified the server will start a new workflow execution in
-	// case of a workflow failure. Either way retries will never exceed
-	// [WorkflowExecutionTimeout].
-	//
-	// See https://docs.temporal.io/retry-policies.
-	// Optional: default = none (no retries for workflows).
-	RetryPolicy *v11.RetryPolicy `protobuf:"bytes,8,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	WorkflowExecutionErrorWhenAlreadyStarted *bool `protobuf:"varint,7,opt,name=workflow_execution_error_when_already_started,json=workflowExecutionErrorWhenAlreadyStarted,proto3,oneof" json:"workflow_execution_error_when_already_started,omitempty"`
+	// Types that are assignable to Retry:
+	//	*StartWorkflowOptions_RetryPolicy
+	//	*StartWorkflowOptions_RetryPolicyRef
+	Retry isStartWorkflowOptions_Retry `protobuf_oneof:"retry"`
 	// If a cron schedule is specified, the workflow will run as a cron based
 	// on the schedule - See https://docs.temporal.io/workflows#temporal-cron-job
 	// for details.
@@ -456,7 +647,11 @@ type StartWorkflowOptions struct {
 	//
 	// A cron workflow will not stop until it is terminated or canceled (by
 	// returning `temporal.CanceledError`).
-	CronSchedule string `protobuf:"bytes,9,opt,name=cron_schedule,json=cronSchedule,proto3" json:"cron_schedule,omitempty"`
+	CronSchedule *string `protobuf:"bytes,9,opt,name=cron_schedule,json=cronSchedule,proto3,oneof" json:"cron_schedule,omitempty"`
+	// Specifies whether a child workflow should run on a worker with a build
+	// ID which is compatible with the parent's worker, when using worker
+	// versioning. Ignored when the workflow isn't started as a child.
+	VersioningIntent VersioningIntent `protobuf:"varint,11,opt,name=versioning_intent,json=versioningIntent,proto3,enum=temporal.VersioningIntent" json:"versioning_intent,omitempty"`
 }
 
 func (x *StartWorkflowOptions) Reset() {
@@ -492,15 +687,15 @@ func (*StartWorkflowOptions) Descriptor() ([]byte, []int) {
 }
 
 func (x *StartWorkflowOptions) GetId() string {
-	if x != nil {
-		return x.Id
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
 	return ""
 }
 
 func (x *StartWorkflowOptions) GetTaskQueue() string {
-	if x != nil {
-		return x.TaskQueue
+	if x != nil && x.TaskQueue != nil {
+		return *x.TaskQueue
 	}
 	return ""
 }
@@ -527,35 +722,84 @@ func (x *StartWorkflowOptions) GetWorkflowTaskTimeout() *durationpb.Duration {
 }
 
 func (x *StartWorkflowOptions) GetWorkflowIdReusePolicy() v1.WorkflowIdReusePolicy {
-	if x != nil {
-		return x.WorkflowIdReusePolicy
+	if x != nil && x.WorkflowIdReusePolicy != nil {
+		return *x.WorkflowIdReusePolicy
 	}
 	return v1.WorkflowIdReusePolicy(0)
 }
 
 func (x *StartWorkflowOptions) GetWorkflowExecutionErrorWhenAlreadyStarted() bool {
-	if x != nil {
-		return x.WorkflowExecutionErrorWhenAlreadyStarted
+	if x != nil && x.WorkflowExecutionErrorWhenAlreadyStarted != nil {
+		return *x.WorkflowExecutionErrorWhenAlreadyStarted
 	}
 	return false
 }
 
+func (m *StartWorkflowOptions) GetRetry() isStartWorkflowOptions_Retry {
+	if m != nil {
+		return m.Retry
+	}
+	return nil
+}
+
 func (x *StartWorkflowOptions) GetRetryPolicy() *v11.RetryPolicy {
-	if x != nil {
+	if x, ok := x.GetRetry().(*StartWorkflowOptions_RetryPolicy); ok {
 		return x.RetryPolicy
 	}
 	return nil
 }
 
+func (x *StartWorkflowOptions) GetRetryPolicyRef() string {
+	if x, ok := x.GetRetry().(*StartWorkflowOptions_RetryPolicyRef); ok {
+		return x.RetryPolicyRef
+	}
+	return ""
+}
+
 func (x *StartWorkflowOptions) GetCronSchedule() string {
-	if x != nil {
-		return x.CronSchedule
+	if x != nil && x.CronSchedule != nil {
+		return *x.CronSchedule
 	}
 	return ""
 }
 
+func (x *StartWorkflowOptions) GetVersioningIntent() VersioningIntent {
+	if x != nil {
+		return x.VersioningIntent
+	}
+	return VersioningIntent_VERSIONING_INTENT_UNSPECIFIED
+}
+
+type isStartWorkflowOptions_Retry interface {
+	isStartWorkflowOptions_Retry()
+}
+
+type StartWorkflowOptions_RetryPolicy struct {
+	// Typically used in activities rather than workflows, but if a retry
+	// policy is specified the server will start a new workflow execution
+	// in case of a workflow failure. Either way retries will never exceed
+	// [WorkflowExecutionTimeout].
+	//
+	// See https://docs.temporal.io/retry-policies.
+	// Optional: default = none (no retries for workflows).
+	RetryPolicy *v11.RetryPolicy `protobuf:"bytes,8,opt,name=retry_policy,json=retryPolicy,proto3,oneof"`
+}
+
+type StartWorkflowOptions_RetryPolicyRef struct {
+	// The name of a retry policy preset, instead of [RetryPolicy].
+	// See [RetryPolicyPreset] for details.
+	RetryPolicyRef string `protobuf:"bytes,10,opt,name=retry_policy_ref,json=retryPolicyRef,proto3,oneof"`
+}
+
+func (*StartWorkflowOptions_RetryPolicy) isStartWorkflowOptions_Retry() {}
+
+func (*StartWorkflowOptions_RetryPolicyRef) isStartWorkflowOptions_Retry() {}
+
 // ActivityOptions represents https://pkg.go.dev/go.temporal.io/sdk/workflow#ActivityOptions.
 // See also https://docs.temporal.io/activities#activity-execution.
+//
+// Activity options may be specified in 3 levels, like [StartWorkflowOptions]:
+// file defaults, service defaults, and method options.
 type ActivityOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -565,7 +809,7 @@ type ActivityOptions struct {
 	//
 	// See https://docs.temporal.io/tasks#task-queue.
 	// Optional: default = the workflow's task queue.
-	TaskQueue string `protobuf:"bytes,1,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	TaskQueue *string `protobuf:"bytes,1,opt,name=task_queue,json=taskQueue,proto3,oneof" json:"task_queue,omitempty"`
 	// The maximum amount of time allowed for the overall Activity Execution,
 	// from when the first Activity Task is scheduled to when the last Activity
 	// Task, in the chain of Activity Tasks that make up the Activity Execution
@@ -629,21 +873,15 @@ type ActivityOptions struct {
 	// TODO: Field comment.
 	// See https://docs.temporal.io/activities#cancellation.
 	// Optional: default = false.
-	WaitForCancellation bool `protobuf:"varint,6,opt,name=wait_for_cancellation,json=waitForCancellation,proto3" json:"wait_for_cancellation,omitempty"`
+	WaitForCancellation *bool `protobuf:"varint,6,opt,name=wait_for_cancellation,json=waitForCancellation,proto3,oneof" json:"wait_for_cancellation,omitempty"`
 	// TODO: Field comment.
 	// See https://docs.temporal.io/activities#activity-id.
 	// Optional: default = empty string.
-	ActivityId string `protobuf:"bytes,7,opt,name=activity_id,json=activityId,proto3" json:"activity_id,omitempty"`
-	// To disable retries set Maximum Attempts to 1.
-	// See https://docs.temporal.io/retry-policies for details.
-	// Optional: default =
-	//
-	//	Initial Interval     = 1 second
-	//	Backoff Coefficient  = 2.0
-	//	Maximum Interval     = 100 × Initial Interval
-	//	Maximum Attempts     = 0 (unlimited)
-	//	Non-Retryable Errors = none
-	RetryPolicy *v11.RetryPolicy `protobuf:"bytes,8,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	ActivityId *string `protobuf:"bytes,7,opt,name=activity_id,json=activityId,proto3,oneof" json:"activity_id,omitempty"`
+	// Types that are assignable to Retry:
+	//	*ActivityOptions_RetryPolicy
+	//	*ActivityOptions_RetryPolicyRef
+	Retry isActivityOptions_Retry `protobuf_oneof:"retry"`
 	// If true, will not request eager execution regardless of worker settings.
 	// If false, eager execution may still be disabled at the worker level or
 	// eager execution may not be requested due to lack of available slots.
@@ -651,7 +889,11 @@ type ActivityOptions struct {
 	// Eager activity execution means the server returns requested eager
 	// activities directly from the workflow task back to this worker which is
 	// faster than non-eager which may be dispatched to a separate worker.
-	DisableEagerExecution bool `protobuf:"varint,9,opt,name=disable_eager_execution,json=disableEagerExecution,proto3" json:"disable_eager_execution,omitempty"`
+	DisableEagerExecution *bool `protobuf:"varint,9,opt,name=disable_eager_execution,json=disableEagerExecution,proto3,oneof" json:"disable_eager_execution,omitempty"`
+	// Specifies whether the activity should run on a worker with a build ID
+	// which is compatible with the workflow's worker, when using worker
+	// versioning. Ignored by local activities.
+	VersioningIntent VersioningIntent `protobuf:"varint,11,opt,name=versioning_intent,json=versioningIntent,proto3,enum=temporal.VersioningIntent" json:"versioning_intent,omitempty"`
 }
 
 func (x *ActivityOptions) Reset() {
@@ -687,8 +929,8 @@ func (*ActivityOptions) Descriptor() ([]byte, []int) {
 }
 
 func (x *ActivityOptions) GetTaskQueue() string {
-	if x != nil {
-		return x.TaskQueue
+	if x != nil && x.TaskQueue != nil {
+		return *x.TaskQueue
 	}
 	return ""
 }
@@ -722,33 +964,146 @@ func (x *ActivityOptions) GetHeartbeatTimeout() *durationpb.Duration {
 }
 
 func (x *ActivityOptions) GetWaitForCancellation() bool {
-	if x != nil {
-		return x.WaitForCancellation
+	if x != nil && x.WaitForCancellation != nil {
+		return *x.WaitForCancellation
 	}
 	return false
 }
 
 func (x *ActivityOptions) GetActivityId() string {
-	if x != nil {
-		return x.ActivityId
+	if x != nil && x.ActivityId != nil {
+		return *x.ActivityId
 	}
 	return ""
 }
 
+func (m *ActivityOptions) GetRetry() isActivityOptions_Retry {
+	if m != nil {
+		return m.Retry
+	}
+	return nil
+}
+
 func (x *ActivityOptions) GetRetryPolicy() *v11.RetryPolicy {
-	if x != nil {
+	if x, ok := x.GetRetry().(*ActivityOptions_RetryPolicy); ok {
 		return x.RetryPolicy
 	}
 	return nil
 }
 
+func (x *ActivityOptions) GetRetryPolicyRef() string {
+	if x, ok := x.GetRetry().(*ActivityOptions_RetryPolicyRef); ok {
+		return x.RetryPolicyRef
+	}
+	return ""
+}
+
 func (x *ActivityOptions) GetDisableEagerExecution() bool {
-	if x != nil {
-		return x.DisableEagerExecution
+	if x != nil && x.DisableEagerExecution != nil {
+		return *x.DisableEagerExecution
 	}
 	return false
 }
 
+func (x *ActivityOptions) GetVersioningIntent() VersioningIntent {
+	if x != nil {
+		return x.VersioningIntent
+	}
+	return VersioningIntent_VERSIONING_INTENT_UNSPECIFIED
+}
+
+type isActivityOptions_Retry interface {
+	isActivityOptions_Retry()
+}
+
+type ActivityOptions_RetryPolicy struct {
+	// To disable retries set Maximum Attempts to 1.
+	// See https://docs.temporal.io/retry-policies for details.
+	// Optional: default =
+	//
+	//	Initial Interval     = 1 second
+	//	Backoff Coefficient  = 2.0
+	//	Maximum Interval     = 100 × Initial Interval
+	//	Maximum Attempts     = 0 (unlimited)
+	//	Non-Retryable Errors = none
+	RetryPolicy *v11.RetryPolicy `protobuf:"bytes,8,opt,name=retry_policy,json=retryPolicy,proto3,oneof"`
+}
+
+type ActivityOptions_RetryPolicyRef struct {
+	// The name of a retry policy preset, instead of [RetryPolicy].
+	// See [RetryPolicyPreset] for details.
+	RetryPolicyRef string `protobuf:"bytes,10,opt,name=retry_policy_ref,json=retryPolicyRef,proto3,oneof"`
+}
+
+func (*ActivityOptions_RetryPolicy) isActivityOptions_Retry() {}
+
+func (*ActivityOptions_RetryPolicyRef) isActivityOptions_Retry() {}
+
+// RetryPolicyPreset is a named retry policy, which can be defined once per
+// file or service, and then referenced by name in activity and workflow
+// options (`retry_policy_ref`), instead of repeating the same `retry_policy`.
+//
+// References are resolved in this order: the presets of the method's service,
+// the presets of the method's file, and then the presets of all the files
+// that the method's file imports (directly or indirectly) - in which a name
+// must be unique. The generator emits each preset as an exported Go variable,
+// next to the code which is generated for the file that defines it.
+type RetryPolicyPreset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required: unique within its file or service, and a valid identifier.
+	Name   string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Policy *v11.RetryPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *RetryPolicyPreset) Reset() {
+	*x = RetryPolicyPreset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_worker_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryPolicyPreset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicyPreset) ProtoMessage() {}
+
+func (x *RetryPolicyPreset) ProtoReflect() protoreflect.Message {
+	mi := &file_worker_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicyPreset.ProtoReflect.Descriptor instead.
+func (*RetryPolicyPreset) Descriptor() ([]byte, []int) {
+	return file_worker_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RetryPolicyPreset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RetryPolicyPreset) GetPolicy() *v11.RetryPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
 type Worker struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -756,12 +1111,40 @@ type Worker struct {
 
 	TaskQueue string         `protobuf:"bytes,1,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
 	Options   *WorkerOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	// Default options for all the activities in the service, which override
+	// the file's default options, and are overridden by method options.
+	DefaultActivityOptions *ActivityOptions `protobuf:"bytes,3,opt,name=default_activity_options,json=defaultActivityOptions,proto3" json:"default_activity_options,omitempty"`
+	// Default options for all the workflows in the service, which override
+	// the file's default options, and are overridden by method options.
+	DefaultWorkflowOptions *StartWorkflowOptions `protobuf:"bytes,4,opt,name=default_workflow_options,json=defaultWorkflowOptions,proto3" json:"default_workflow_options,omitempty"`
+	// Retry policies which methods in this service can reference by name.
+	RetryPolicies []*RetryPolicyPreset `protobuf:"bytes,5,rep,name=retry_policies,json=retryPolicies,proto3" json:"retry_policies,omitempty"`
+	// The encoding of proto messages in Temporal payloads. If specified, the
+	// generator emits a data converter for the service, and a function which
+	// creates clients with it. Services which share a task queue must also
+	// share the same encoding.
+	PayloadEncoding PayloadEncoding `protobuf:"varint,6,opt,name=payload_encoding,json=payloadEncoding,proto3,enum=temporal.PayloadEncoding" json:"payload_encoding,omitempty"`
+	// Opt-in size threshold (in bytes) of large payloads, which are stored
+	// in a blob store and replaced with references ("claim checks") in
+	// Temporal. If specified, the generator emits a function which wraps data
+	// converters for the service, see the "claimcheck" package of this module.
+	// Services which share a task queue must also share the same threshold.
+	LargePayloadThreshold int64 `protobuf:"varint,7,opt,name=large_payload_threshold,json=largePayloadThreshold,proto3" json:"large_payload_threshold,omitempty"`
+	// Opt-in OpenTelemetry instrumentation: generated client helpers and
+	// worker activities create spans which are named after the fully
+	// qualified rpc, see the "tracing" package of this module. Workflows are
+	// not instrumented, because spans in workflow code aren't deterministic.
+	Tracing bool `protobuf:"varint,8,opt,name=tracing,proto3" json:"tracing,omitempty"`
+	// Opt-in metrics: generated client helpers and registered workflows and
+	// activities record call counts, latencies and failure counts with the
+	// SDK's client.MetricsHandler, tagged by proto service and method.
+	Metrics bool `protobuf:"varint,9,opt,name=metrics,proto3" json:"metrics,omitempty"`
 }
 
 func (x *Worker) Reset() {
 	*x = Worker{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_worker_proto_msgTypes[3]
+		mi := &file_worker_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -774,7 +1157,7 @@ func (x *Worker) String() string {
 func (*Worker) ProtoMessage() {}
 
 func (x *Worker) ProtoReflect() protoreflect.Message {
-	mi := &file_worker_proto_msgTypes[3]
+	mi := &file_worker_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,7 +1170,7 @@ func (x *Worker) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Worker.ProtoReflect.Descriptor instead.
 func (*Worker) Descriptor() ([]byte, []int) {
-	return file_worker_proto_rawDescGZIP(), []int{3}
+	return file_worker_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Worker) GetTaskQueue() string {
@@ -804,18 +1187,313 @@ func (x *Worker) GetOptions() *WorkerOptions {
 	return nil
 }
 
+func (x *Worker) GetDefaultActivityOptions() *ActivityOptions {
+	if x != nil {
+		return x.DefaultActivityOptions
+	}
+	return nil
+}
+
+func (x *Worker) GetDefaultWorkflowOptions() *StartWorkflowOptions {
+	if x != nil {
+		return x.DefaultWorkflowOptions
+	}
+	return nil
+}
+
+func (x *Worker) GetRetryPolicies() []*RetryPolicyPreset {
+	if x != nil {
+		return x.RetryPolicies
+	}
+	return nil
+}
+
+func (x *Worker) GetPayloadEncoding() PayloadEncoding {
+	if x != nil {
+		return x.PayloadEncoding
+	}
+	return PayloadEncoding_PAYLOAD_ENCODING_UNSPECIFIED
+}
+
+func (x *Worker) GetLargePayloadThreshold() int64 {
+	if x != nil {
+		return x.LargePayloadThreshold
+	}
+	return 0
+}
+
+func (x *Worker) GetTracing() bool {
+	if x != nil {
+		return x.Tracing
+	}
+	return false
+}
+
+func (x *Worker) GetMetrics() bool {
+	if x != nil {
+		return x.Metrics
+	}
+	return false
+}
+
+// File contains file-level defaults for all the services in a proto file.
+type File struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Default options for all the activities in the file, which are
+	// overridden by service default options and method options.
+	DefaultActivityOptions *ActivityOptions `protobuf:"bytes,1,opt,name=default_activity_options,json=defaultActivityOptions,proto3" json:"default_activity_options,omitempty"`
+	// Default options for all the workflows in the file, which are
+	// overridden by service default options and method options.
+	DefaultWorkflowOptions *StartWorkflowOptions `protobuf:"bytes,2,opt,name=default_workflow_options,json=defaultWorkflowOptions,proto3" json:"default_workflow_options,omitempty"`
+	// Retry policies which methods in this file, and in files which import
+	// it, can reference by name.
+	RetryPolicies []*RetryPolicyPreset `protobuf:"bytes,3,rep,name=retry_policies,json=retryPolicies,proto3" json:"retry_policies,omitempty"`
+}
+
+func (x *File) Reset() {
+	*x = File{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_worker_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*File) ProtoMessage() {}
+
+func (x *File) ProtoReflect() protoreflect.Message {
+	mi := &file_worker_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use File.ProtoReflect.Descriptor instead.
+func (*File) Descriptor() ([]byte, []int) {
+	return file_worker_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *File) GetDefaultActivityOptions() *ActivityOptions {
+	if x != nil {
+		return x.DefaultActivityOptions
+	}
+	return nil
+}
+
+func (x *File) GetDefaultWorkflowOptions() *StartWorkflowOptions {
+	if x != nil {
+		return x.DefaultWorkflowOptions
+	}
+	return nil
+}
+
+func (x *File) GetRetryPolicies() []*RetryPolicyPreset {
+	if x != nil {
+		return x.RetryPolicies
+	}
+	return nil
+}
+
+// ErrorType declares an application error which a workflow or an activity
+// may return, with typed details. The generator emits a constructor and a
+// matcher for each error type, and adds non-retryable error types to the
+// "non_retryable_error_types" of the method's retry policy. Workflows without
+// a retry policy aren't retried at all, so they don't get one.
+type ErrorType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required: the type of the Temporal application error, which must be a
+	// valid identifier, and is unique within its service.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Required: name of a proto message with the error details, relative to
+	// the package of the method's file or fully qualified.
+	Details      string `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	NonRetryable bool   `protobuf:"varint,3,opt,name=non_retryable,json=nonRetryable,proto3" json:"non_retryable,omitempty"`
+}
+
+func (x *ErrorType) Reset() {
+	*x = ErrorType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_worker_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorType) ProtoMessage() {}
+
+func (x *ErrorType) ProtoReflect() protoreflect.Message {
+	mi := &file_worker_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorType.ProtoReflect.Descriptor instead.
+func (*ErrorType) Descriptor() ([]byte, []int) {
+	return file_worker_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ErrorType) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ErrorType) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *ErrorType) GetNonRetryable() bool {
+	if x != nil {
+		return x.NonRetryable
+	}
+	return false
+}
+
+// VersionChange declares a change in the code of a workflow, which is guarded
+// by workflow.GetVersion for backward compatibility with running workflows.
+// See https://docs.temporal.io/dev-guide/go/versioning#workflow-versioning.
+// The generator emits typed constants and a GetVersion helper for it.
+type VersionChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required: the change ID, which must be a valid identifier, and is
+	// unique within its workflow.
+	ChangeId string `protobuf:"bytes,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	// Default: workflow.DefaultVersion (-1), i.e. the code before the change.
+	MinSupportedVersion *int32 `protobuf:"varint,2,opt,name=min_supported_version,json=minSupportedVersion,proto3,oneof" json:"min_supported_version,omitempty"`
+	// Required: at least min_supported_version.
+	MaxSupportedVersion int32  `protobuf:"varint,3,opt,name=max_supported_version,json=maxSupportedVersion,proto3" json:"max_supported_version,omitempty"`
+	Description         string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Marks a change whose GetVersion call was removed from the workflow's
+	// code. This is not allowed while its min_supported_version is above
+	// workflow.DefaultVersion, because running workflows may still depend on
+	// the change's history markers. Removed changes stay declared, to prevent
+	// the reuse of their IDs, but the generator doesn't emit code for them.
+	Removed bool `protobuf:"varint,5,opt,name=removed,proto3" json:"removed,omitempty"`
+}
+
+func (x *VersionChange) Reset() {
+	*x = VersionChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_worker_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionChange) ProtoMessage() {}
+
+func (x *VersionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_worker_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionChange.ProtoReflect.Descriptor instead.
+func (*VersionChange) Descriptor() ([]byte, []int) {
+	return file_worker_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VersionChange) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+func (x *VersionChange) GetMinSupportedVersion() int32 {
+	if x != nil && x.MinSupportedVersion != nil {
+		return *x.MinSupportedVersion
+	}
+	return 0
+}
+
+func (x *VersionChange) GetMaxSupportedVersion() int32 {
+	if x != nil {
+		return x.MaxSupportedVersion
+	}
+	return 0
+}
+
+func (x *VersionChange) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *VersionChange) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
 type Workflow struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Options *StartWorkflowOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	Errors  []*ErrorType          `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	// If positive, the generated ContinueAsNew helper of the workflow
+	// continues it as new only when the workflow's history has at least this
+	// number of events (see workflow.GetInfo(ctx).GetCurrentHistoryLength),
+	// and returns nil otherwise.
+	ContinueAsNewHistoryLength uint32           `protobuf:"varint,3,opt,name=continue_as_new_history_length,json=continueAsNewHistoryLength,proto3" json:"continue_as_new_history_length,omitempty"`
+	VersionChanges             []*VersionChange `protobuf:"bytes,4,rep,name=version_changes,json=versionChanges,proto3" json:"version_changes,omitempty"`
+	// Names of the activities and child workflows which the workflow calls,
+	// relative to the package of the workflow's file or fully qualified
+	// (e.g. "my.pkg.Service.Method"). They're drawn as edges in generated
+	// topology diagrams, and must be defined in the same file or its imports.
+	Calls []string `protobuf:"bytes,5,rep,name=calls,proto3" json:"calls,omitempty"`
 }
 
 func (x *Workflow) Reset() {
 	*x = Workflow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_worker_proto_msgTypes[4]
+		mi := &file_worker_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -828,7 +1506,7 @@ func (x *Workflow) String() string {
 func (*Workflow) ProtoMessage() {}
 
 func (x *Workflow) ProtoReflect() protoreflect.Message {
-	mi := &file_worker_proto_msgTypes[4]
+	mi := &file_worker_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -841,7 +1519,7 @@ func (x *Workflow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Workflow.ProtoReflect.Descriptor instead.
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return file_worker_proto_rawDescGZIP(), []int{4}
+	return file_worker_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Workflow) GetOptions() *StartWorkflowOptions {
@@ -851,18 +1529,52 @@ func (x *Workflow) GetOptions() *StartWorkflowOptions {
 	return nil
 }
 
+func (x *Workflow) GetErrors() []*ErrorType {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *Workflow) GetContinueAsNewHistoryLength() uint32 {
+	if x != nil {
+		return x.ContinueAsNewHistoryLength
+	}
+	return 0
+}
+
+func (x *Workflow) GetVersionChanges() []*VersionChange {
+	if x != nil {
+		return x.VersionChanges
+	}
+	return nil
+}
+
+func (x *Workflow) GetCalls() []string {
+	if x != nil {
+		return x.Calls
+	}
+	return nil
+}
+
 type Activity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Options *ActivityOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	// Name of a proto message which the activity reports as heartbeat
+	// details, relative to the package of the activity's file or fully
+	// qualified (e.g. "my.pkg.Progress"). The generator emits typed
+	// RecordHeartbeat and GetHeartbeat helpers for it.
+	HeartbeatDetails string       `protobuf:"bytes,2,opt,name=heartbeat_details,json=heartbeatDetails,proto3" json:"heartbeat_details,omitempty"`
+	Errors           []*ErrorType `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
 func (x *Activity) Reset() {
 	*x = Activity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_worker_proto_msgTypes[5]
+		mi := &file_worker_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -875,7 +1587,7 @@ func (x *Activity) String() string {
 func (*Activity) ProtoMessage() {}
 
 func (x *Activity) ProtoReflect() protoreflect.Message {
-	mi := &file_worker_proto_msgTypes[5]
+	mi := &file_worker_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -888,7 +1600,7 @@ func (x *Activity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Activity.ProtoReflect.Descriptor instead.
 func (*Activity) Descriptor() ([]byte, []int) {
-	return file_worker_proto_rawDescGZIP(), []int{5}
+	return file_worker_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Activity) GetOptions() *ActivityOptions {
@@ -898,7 +1610,37 @@ func (x *Activity) GetOptions() *ActivityOptions {
 	return nil
 }
 
+func (x *Activity) GetHeartbeatDetails() string {
+	if x != nil {
+		return x.HeartbeatDetails
+	}
+	return ""
+}
+
+func (x *Activity) GetErrors() []*ErrorType {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
 var file_worker_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*File)(nil),
+		Field:         7236,
+		Name:          "temporal.file",
+		Tag:           "bytes,7236,opt,name=file",
+		Filename:      "worker.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         7237,
+		Name:          "temporal.sensitive",
+		Tag:           "varint,7237,opt,name=sensitive",
+		Filename:      "worker.proto",
+	},
 	{
 		ExtendedType:  (*descriptorpb.ServiceOptions)(nil),
 		ExtensionType: (*Worker)(nil),
@@ -925,18 +1667,30 @@ var file_worker_proto_extTypes = []protoimpl.ExtensionInfo{
 	},
 }
 
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// optional temporal.File file = 7236;
+	E_File = &file_worker_proto_extTypes[0]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional bool sensitive = 7237;
+	E_Sensitive = &file_worker_proto_extTypes[1]
+)
+
 // Extension fields to descriptorpb.ServiceOptions.
 var (
 	// optional temporal.Worker worker = 7233;
-	E_Worker = &file_worker_proto_extTypes[0]
+	E_Worker = &file_worker_proto_extTypes[2]
 )
 
 // Extension fields to descriptorpb.MethodOptions.
 var (
 	// optional temporal.Workflow workflow = 7234;
-	E_Workflow = &file_worker_proto_extTypes[1]
+	E_Workflow = &file_worker_proto_extTypes[3]
 	// optional temporal.Activity activity = 7235;
-	E_Activity = &file_worker_proto_extTypes[2]
+	E_Activity = &file_worker_proto_extTypes[4]
 )
 
 var File_worker_proto protoreflect.FileDescriptor
@@ -952,7 +1706,7 @@ var file_worker_proto_rawDesc = []byte{
 	0x76, 0x31, 0x2f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x24, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65,
 	0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x0e, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc0, 0x0f, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x65,
 	0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x52, 0x0a, 0x26, 0x6d, 0x61, 0x78, 0x5f,
 	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
 	0x69, 0x74, 0x79, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69,
@@ -1007,176 +1761,336 @@ var file_worker_proto_rawDesc = []byte{
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1c, 0x73, 0x74, 0x69, 0x63, 0x6b,
 	0x79, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x49, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0e,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x51, 0x0a, 0x15, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x61, 0x6e, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x6e, 0x69, 0x63, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x50,
+	0x61, 0x6e, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x49, 0x0a, 0x13, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x25, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x21, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x64,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x1a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x57, 0x0a, 0x1a,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x18, 0x64, 0x65, 0x61,
+	0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x60, 0x0a, 0x1f, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1c, 0x6d, 0x61, 0x78, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x68, 0x0a, 0x23, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x74, 0x68, 0x72,
+	0x6f, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x16,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x11, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x13, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x25, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f,
-	0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x21, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x69, 0x73, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x77, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x64, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x12, 0x3b, 0x0a, 0x1a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x12,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1a, 0x0a,
-	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x57, 0x0a, 0x1a, 0x64, 0x65, 0x61,
-	0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x18, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x6f,
-	0x63, 0x6b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x12, 0x60, 0x0a, 0x1f, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62,
-	0x65, 0x61, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1c, 0x6d, 0x61, 0x78, 0x48, 0x65, 0x61, 0x72, 0x74,
-	0x62, 0x65, 0x61, 0x74, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x76, 0x61, 0x6c, 0x12, 0x68, 0x0a, 0x23, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
-	0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74,
-	0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x20, 0x64, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x54, 0x68,
-	0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x38,
-	0x0a, 0x18, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x61, 0x67, 0x65, 0x72, 0x5f,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x16, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x61, 0x67, 0x65, 0x72, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x2c, 0x6d, 0x61, 0x78, 0x5f,
-	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x61, 0x67, 0x65, 0x72,
-	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x27,
-	0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x45, 0x61, 0x67,
-	0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x42, 0x0a, 0x1d, 0x64, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x61, 0x6c, 0x69, 0x61, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b,
-	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a, 0x08, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x1b, 0x75, 0x73, 0x65, 0x5f, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x75, 0x73, 0x65,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x46, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x69, 0x6e, 0x67, 0x22, 0xef, 0x04, 0x0a, 0x14, 0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x6f,
-	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
-	0x0a, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x57, 0x0a, 0x1a,
-	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x38, 0x0a, 0x18, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x61, 0x67,
+	0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x16, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x61, 0x67, 0x65,
+	0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x2c, 0x6d,
+	0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x61,
+	0x67, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x27, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x45, 0x61, 0x67, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x42, 0x0a, 0x1d, 0x64, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x1b, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x1b, 0x75, 0x73, 0x65,
+	0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17,
+	0x75, 0x73, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x46, 0x6f, 0x72, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x74, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x1e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x74, 0x69, 0x62, 0x6c, 0x65,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x0c, 0x10, 0x0d, 0x4a, 0x04, 0x08,
+	0x17, 0x10, 0x18, 0x4a, 0x04, 0x08, 0x18, 0x10, 0x19, 0x22, 0xff, 0x06, 0x0a, 0x14, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x09, 0x74,
+	0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x57, 0x0a, 0x1a, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x18, 0x77, 0x6f, 0x72, 0x6b,
+	0x66, 0x6c, 0x6f, 0x77, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x4b, 0x0a, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x75, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x4d, 0x0a, 0x15, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x61,
+	0x73, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x18, 0x77, 0x6f, 0x72,
-	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x4b, 0x0a, 0x14, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
-	0x77, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12,
-	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x75, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x12, 0x4d, 0x0a, 0x15, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x74,
-	0x61, 0x73, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x77, 0x6f,
-	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x12, 0x65, 0x0a, 0x18, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64,
-	0x5f, 0x72, 0x65, 0x75, 0x73, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
-	0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x52, 0x65, 0x75, 0x73, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x52, 0x15, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x52, 0x65, 0x75,
-	0x73, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x5f, 0x0a, 0x2d, 0x77, 0x6f, 0x72, 0x6b,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x77, 0x6f, 0x72,
+	0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x12, 0x6a, 0x0a, 0x18, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x5f,
+	0x72, 0x65, 0x75, 0x73, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x49, 0x64, 0x52, 0x65, 0x75, 0x73, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x48, 0x03, 0x52, 0x15, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x52, 0x65,
+	0x75, 0x73, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x64, 0x0a, 0x2d,
+	0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x77, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6c,
+	0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x28, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x57, 0x68, 0x65,
+	0x6e, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x48, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x6c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x00, 0x52,
+	0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2a, 0x0a, 0x10,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x72, 0x65, 0x66,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x72, 0x79, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x66, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x72, 0x6f, 0x6e,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x05, 0x52, 0x0c, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x47, 0x0a, 0x11, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x75, 0x73, 0x65,
+	0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x30, 0x0a, 0x2e, 0x5f, 0x77, 0x6f, 0x72, 0x6b,
 	0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65,
 	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x77, 0x68, 0x65, 0x6e, 0x5f, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64,
-	0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x28, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x57, 0x68, 0x65, 0x6e, 0x41, 0x6c, 0x72, 0x65, 0x61,
-	0x64, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x46, 0x0a, 0x0c, 0x72, 0x65, 0x74,
-	0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0xc9, 0x04, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61,
-	0x73, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x74, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x54, 0x0a, 0x19, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x79, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x72,
+	0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0xb2, 0x06, 0x0a, 0x0f,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x22, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x54, 0x0a, 0x19, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f,
+	0x74, 0x6f, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x16, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x6f, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x54, 0x0a, 0x19, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
 	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x54, 0x6f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
-	0x54, 0x0a, 0x19, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x4e, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
-	0x6f, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x13, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x46, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
-	0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x68, 0x65, 0x61,
-	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x32, 0x0a,
-	0x15, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x77, 0x61,
-	0x69, 0x74, 0x46, 0x6f, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x49, 0x64, 0x12, 0x46, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
-	0x72, 0x61, 0x6c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x72,
-	0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x64, 0x69,
-	0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x64, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x45, 0x61, 0x67, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x06, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
-	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74,
-	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x44,
-	0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x38, 0x0a, 0x07, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65,
-	0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b,
-	0x66, 0x6c, 0x6f, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3f, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
-	0x12, 0x33, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x69, 0x74, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x4a, 0x0a, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12,
-	0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0xc1, 0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
-	0x61, 0x6c, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x3a, 0x4f, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x1e, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xc2, 0x38,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e,
-	0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
-	0x6f, 0x77, 0x3a, 0x4f, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x1e,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xc3,
-	0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
-	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x64, 0x61, 0x61, 0x62, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67,
-	0x65, 0x6e, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2d, 0x67, 0x6f, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x54, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x4e, 0x0a, 0x16, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x63, 0x6c, 0x6f, 0x73,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x6f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x46, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x37, 0x0a, 0x15, 0x77, 0x61, 0x69, 0x74, 0x5f,
+	0x66, 0x6f, 0x72, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x13, 0x77, 0x61, 0x69, 0x74, 0x46, 0x6f,
+	0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x5f, 0x72, 0x65, 0x66, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x66, 0x12, 0x3b, 0x0a, 0x17,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52,
+	0x15, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x61, 0x67, 0x65, 0x72, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x11, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x52, 0x10, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x77,
+	0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x5f, 0x69, 0x64, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x65, 0x61, 0x67, 0x65, 0x72, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x64, 0x0a, 0x11, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xff, 0x03, 0x0a, 0x06, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x31, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x53, 0x0a, 0x18, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x16, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x58, 0x0a, 0x18, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x6d,
+	0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x16, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x42, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x6d,
+	0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x17,
+	0x6c, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6c,
+	0x61, 0x72, 0x67, 0x65, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x22, 0xf9, 0x01, 0x0a, 0x04, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x53, 0x0a, 0x18, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x16,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x58, 0x0a, 0x18, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f,
+	0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x16, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x42, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x6c, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x6e, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e, 0x6f, 0x6e, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x61, 0x62, 0x6c, 0x65, 0x22, 0xef, 0x01, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x00, 0x52, 0x13, 0x6d, 0x69, 0x6e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x15,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x6d, 0x61, 0x78,
+	0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x42, 0x18, 0x0a, 0x16,
+	0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x02, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x12, 0x38, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x1e, 0x63, 0x6f,
+	0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x5f, 0x61, 0x73, 0x5f, 0x6e, 0x65, 0x77, 0x5f, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x1a, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x41, 0x73, 0x4e, 0x65,
+	0x77, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x40,
+	0x0a, 0x0f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x6c, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x2a, 0x76, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67,
+	0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x1d, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f,
+	0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x56, 0x45, 0x52,
+	0x53, 0x49, 0x4f, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x43,
+	0x4f, 0x4d, 0x50, 0x41, 0x54, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x56,
+	0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x54,
+	0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x02, 0x2a, 0x8f, 0x01, 0x0a, 0x13, 0x57,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x6e, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x50,
+	0x41, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x57, 0x4f, 0x52,
+	0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f, 0x50, 0x41, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x4c, 0x49,
+	0x43, 0x59, 0x5f, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f,
+	0x57, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x5f,
+	0x50, 0x41, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x46, 0x41, 0x49,
+	0x4c, 0x5f, 0x57, 0x4f, 0x52, 0x4b, 0x46, 0x4c, 0x4f, 0x57, 0x10, 0x02, 0x2a, 0x6b, 0x0a, 0x0f,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x20, 0x0a, 0x1c, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44,
+	0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x45, 0x4e, 0x43,
+	0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x01, 0x12, 0x19,
+	0x0a, 0x15, 0x50, 0x41, 0x59, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49,
+	0x4e, 0x47, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x3a, 0x41, 0x0a, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0xc4, 0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61,
+	0x6c, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x3c, 0x0a, 0x09,
+	0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xc5, 0x38, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x3a, 0x4a, 0x0a, 0x06, 0x77, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xc1, 0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
+	0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x06,
+	0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x3a, 0x4f, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xc2, 0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x08, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x3a, 0x4f, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0xc3, 0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x65, 0x6d,
+	0x70, 0x6f, 0x72, 0x61, 0x6c, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x08,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x61, 0x62, 0x72, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72, 0x61, 0x6c,
+	0x2d, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6f, 0x72,
+	0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1191,50 +2105,77 @@ func file_worker_proto_rawDescGZIP() []byte {
 	return file_worker_proto_rawDescData
 }
 
-var file_worker_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_worker_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_worker_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_worker_proto_goTypes = []interface{}{
-	(*WorkerOptions)(nil),               // 0: temporal.WorkerOptions
-	(*StartWorkflowOptions)(nil),        // 1: temporal.StartWorkflowOptions
-	(*ActivityOptions)(nil),             // 2: temporal.ActivityOptions
-	(*Worker)(nil),                      // 3: temporal.Worker
-	(*Workflow)(nil),                    // 4: temporal.Workflow
-	(*Activity)(nil),                    // 5: temporal.Activity
-	(*durationpb.Duration)(nil),         // 6: google.protobuf.Duration
-	(v1.WorkflowIdReusePolicy)(0),       // 7: temporal.api.enums.v1.WorkflowIdReusePolicy
-	(*v11.RetryPolicy)(nil),             // 8: temporal.api.common.v1.RetryPolicy
-	(*descriptorpb.ServiceOptions)(nil), // 9: google.protobuf.ServiceOptions
-	(*descriptorpb.MethodOptions)(nil),  // 10: google.protobuf.MethodOptions
+	(VersioningIntent)(0),               // 0: temporal.VersioningIntent
+	(WorkflowPanicPolicy)(0),            // 1: temporal.WorkflowPanicPolicy
+	(PayloadEncoding)(0),                // 2: temporal.PayloadEncoding
+	(*WorkerOptions)(nil),               // 3: temporal.WorkerOptions
+	(*StartWorkflowOptions)(nil),        // 4: temporal.StartWorkflowOptions
+	(*ActivityOptions)(nil),             // 5: temporal.ActivityOptions
+	(*RetryPolicyPreset)(nil),           // 6: temporal.RetryPolicyPreset
+	(*Worker)(nil),                      // 7: temporal.Worker
+	(*File)(nil),                        // 8: temporal.File
+	(*ErrorType)(nil),                   // 9: temporal.ErrorType
+	(*VersionChange)(nil),               // 10: temporal.VersionChange
+	(*Workflow)(nil),                    // 11: temporal.Workflow
+	(*Activity)(nil),                    // 12: temporal.Activity
+	(*durationpb.Duration)(nil),         // 13: google.protobuf.Duration
+	(v1.WorkflowIdReusePolicy)(0),       // 14: temporal.api.enums.v1.WorkflowIdReusePolicy
+	(*v11.RetryPolicy)(nil),             // 15: temporal.api.common.v1.RetryPolicy
+	(*descriptorpb.FileOptions)(nil),    // 16: google.protobuf.FileOptions
+	(*descriptorpb.FieldOptions)(nil),   // 17: google.protobuf.FieldOptions
+	(*descriptorpb.ServiceOptions)(nil), // 18: google.protobuf.ServiceOptions
+	(*descriptorpb.MethodOptions)(nil),  // 19: google.protobuf.MethodOptions
 }
 var file_worker_proto_depIdxs = []int32{
-	6,  // 0: temporal.WorkerOptions.sticky_schedule_to_start_timeout:type_name -> google.protobuf.Duration
-	6,  // 1: temporal.WorkerOptions.worker_stop_timeout:type_name -> google.protobuf.Duration
-	6,  // 2: temporal.WorkerOptions.deadlock_detection_timeout:type_name -> google.protobuf.Duration
-	6,  // 3: temporal.WorkerOptions.max_heartbeat_throttle_interval:type_name -> google.protobuf.Duration
-	6,  // 4: temporal.WorkerOptions.default_heartbeat_throttle_interval:type_name -> google.protobuf.Duration
-	6,  // 5: temporal.StartWorkflowOptions.workflow_execution_timeout:type_name -> google.protobuf.Duration
-	6,  // 6: temporal.StartWorkflowOptions.workflow_run_timeout:type_name -> google.protobuf.Duration
-	6,  // 7: temporal.StartWorkflowOptions.workflow_task_timeout:type_name -> google.protobuf.Duration
-	7,  // 8: temporal.StartWorkflowOptions.workflow_id_reuse_policy:type_name -> temporal.api.enums.v1.WorkflowIdReusePolicy
-	8,  // 9: temporal.StartWorkflowOptions.retry_policy:type_name -> temporal.api.common.v1.RetryPolicy
-	6,  // 10: temporal.ActivityOptions.schedule_to_close_timeout:type_name -> google.protobuf.Duration
-	6,  // 11: temporal.ActivityOptions.schedule_to_start_timeout:type_name -> google.protobuf.Duration
-	6,  // 12: temporal.ActivityOptions.start_to_close_timeout:type_name -> google.protobuf.Duration
-	6,  // 13: temporal.ActivityOptions.heartbeat_timeout:type_name -> google.protobuf.Duration
-	8,  // 14: temporal.ActivityOptions.retry_policy:type_name -> temporal.api.common.v1.RetryPolicy
-	0,  // 15: temporal.Worker.options:type_name -> temporal.WorkerOptions
-	1,  // 16: temporal.Workflow.options:type_name -> temporal.StartWorkflowOptions
-	2,  // 17: temporal.Activity.options:type_name -> temporal.ActivityOptions
-	9,  // 18: temporal.worker:extendee -> google.protobuf.ServiceOptions
-	10, // 19: temporal.workflow:extendee -> google.protobuf.MethodOptions
-	10, // 20: temporal.activity:extendee -> google.protobuf.MethodOptions
-	3,  // 21: temporal.worker:type_name -> temporal.Worker
-	4,  // 22: temporal.workflow:type_name -> temporal.Workflow
-	5,  // 23: temporal.activity:type_name -> temporal.Activity
-	24, // [24:24] is the sub-list for method output_type
-	24, // [24:24] is the sub-list for method input_type
-	21, // [21:24] is the sub-list for extension type_name
-	18, // [18:21] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	13, // 0: temporal.WorkerOptions.sticky_schedule_to_start_timeout:type_name -> google.protobuf.Duration
+	1,  // 1: temporal.WorkerOptions.workflow_panic_policy:type_name -> temporal.WorkflowPanicPolicy
+	13, // 2: temporal.WorkerOptions.worker_stop_timeout:type_name -> google.protobuf.Duration
+	13, // 3: temporal.WorkerOptions.deadlock_detection_timeout:type_name -> google.protobuf.Duration
+	13, // 4: temporal.WorkerOptions.max_heartbeat_throttle_interval:type_name -> google.protobuf.Duration
+	13, // 5: temporal.WorkerOptions.default_heartbeat_throttle_interval:type_name -> google.protobuf.Duration
+	13, // 6: temporal.StartWorkflowOptions.workflow_execution_timeout:type_name -> google.protobuf.Duration
+	13, // 7: temporal.StartWorkflowOptions.workflow_run_timeout:type_name -> google.protobuf.Duration
+	13, // 8: temporal.StartWorkflowOptions.workflow_task_timeout:type_name -> google.protobuf.Duration
+	14, // 9: temporal.StartWorkflowOptions.workflow_id_reuse_policy:type_name -> temporal.api.enums.v1.WorkflowIdReusePolicy
+	15, // 10: temporal.StartWorkflowOptions.retry_policy:type_name -> temporal.api.common.v1.RetryPolicy
+	0,  // 11: temporal.StartWorkflowOptions.versioning_intent:type_name -> temporal.VersioningIntent
+	13, // 12: temporal.ActivityOptions.schedule_to_close_timeout:type_name -> google.protobuf.Duration
+	13, // 13: temporal.ActivityOptions.schedule_to_start_timeout:type_name -> google.protobuf.Duration
+	13, // 14: temporal.ActivityOptions.start_to_close_timeout:type_name -> google.protobuf.Duration
+	13, // 15: temporal.ActivityOptions.heartbeat_timeout:type_name -> google.protobuf.Duration
+	15, // 16: temporal.ActivityOptions.retry_policy:type_name -> temporal.api.common.v1.RetryPolicy
+	0,  // 17: temporal.ActivityOptions.versioning_intent:type_name -> temporal.VersioningIntent
+	15, // 18: temporal.RetryPolicyPreset.policy:type_name -> temporal.api.common.v1.RetryPolicy
+	3,  // 19: temporal.Worker.options:type_name -> temporal.WorkerOptions
+	5,  // 20: temporal.Worker.default_activity_options:type_name -> temporal.ActivityOptions
+	4,  // 21: temporal.Worker.default_workflow_options:type_name -> temporal.StartWorkflowOptions
+	6,  // 22: temporal.Worker.retry_policies:type_name -> temporal.RetryPolicyPreset
+	2,  // 23: temporal.Worker.payload_encoding:type_name -> temporal.PayloadEncoding
+	5,  // 24: temporal.File.default_activity_options:type_name -> temporal.ActivityOptions
+	4,  // 25: temporal.File.default_workflow_options:type_name -> temporal.StartWorkflowOptions
+	6,  // 26: temporal.File.retry_policies:type_name -> temporal.RetryPolicyPreset
+	4,  // 27: temporal.Workflow.options:type_name -> temporal.StartWorkflowOptions
+	9,  // 28: temporal.Workflow.errors:type_name -> temporal.ErrorType
+	10, // 29: temporal.Workflow.version_changes:type_name -> temporal.VersionChange
+	5,  // 30: temporal.Activity.options:type_name -> temporal.ActivityOptions
+	9,  // 31: temporal.Activity.errors:type_name -> temporal.ErrorType
+	16, // 32: temporal.file:extendee -> google.protobuf.FileOptions
+	17, // 33: temporal.sensitive:extendee -> google.protobuf.FieldOptions
+	18, // 34: temporal.worker:extendee -> google.protobuf.ServiceOptions
+	19, // 35: temporal.workflow:extendee -> google.protobuf.MethodOptions
+	19, // 36: temporal.activity:extendee -> google.protobuf.MethodOptions
+	8,  // 37: temporal.file:type_name -> temporal.File
+	7,  // 38: temporal.worker:type_name -> temporal.Worker
+	11, // 39: temporal.workflow:type_name -> temporal.Workflow
+	12, // 40: temporal.activity:type_name -> temporal.Activity
+	41, // [41:41] is the sub-list for method output_type
+	41, // [41:41] is the sub-list for method input_type
+	37, // [37:41] is the sub-list for extension type_name
+	32, // [32:37] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_worker_proto_init() }
@@ -1280,7 +2221,7 @@ func file_worker_proto_init() {
 			}
 		}
 		file_worker_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Worker); i {
+			switch v := v.(*RetryPolicyPreset); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1292,7 +2233,7 @@ func file_worker_proto_init() {
 			}
 		}
 		file_worker_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Workflow); i {
+			switch v := v.(*Worker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1304,6 +2245,54 @@ func file_worker_proto_init() {
 			}
 		}
 		file_worker_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_worker_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_worker_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_worker_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Workflow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_worker_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Activity); i {
 			case 0:
 				return &v.state
@@ -1316,18 +2305,28 @@ func file_worker_proto_init() {
 			}
 		}
 	}
+	file_worker_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*StartWorkflowOptions_RetryPolicy)(nil),
+		(*StartWorkflowOptions_RetryPolicyRef)(nil),
+	}
+	file_worker_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*ActivityOptions_RetryPolicy)(nil),
+		(*ActivityOptions_RetryPolicyRef)(nil),
+	}
+	file_worker_proto_msgTypes[7].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_worker_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
-			NumExtensions: 3,
+			NumEnums:      3,
+			NumMessages:   10,
+			NumExtensions: 5,
 			NumServices:   0,
 		},
 		GoTypes:           file_worker_proto_goTypes,
 		DependencyIndexes: file_worker_proto_depIdxs,
+		EnumInfos:         file_worker_proto_enumTypes,
 		MessageInfos:      file_worker_proto_msgTypes,
 		ExtensionInfos:    file_worker_proto_extTypes,
 	}.Build()
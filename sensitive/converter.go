@@ -0,0 +1,92 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package sensitive
+
+import (
+	"reflect"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// PayloadConverter is identical to the PayloadConverter interface of the
+// Temporal Go SDK (https://pkg.go.dev/go.temporal.io/sdk/converter), so
+// implementations of each interface also implement the other one.
+type PayloadConverter interface {
+	// ToPayload converts a single value to a payload.
+	ToPayload(value interface{}) (*commonpb.Payload, error)
+	// FromPayload converts a single payload to a value.
+	FromPayload(payload *commonpb.Payload, valuePtr interface{}) error
+	// ToString converts a payload object into a human-readable string.
+	ToString(*commonpb.Payload) string
+	// Encoding returns the encoding supported by this payload converter.
+	Encoding() string
+}
+
+type payloadConverter struct {
+	PayloadConverter
+	kp KeyProvider
+}
+
+// NewPayloadConverter wraps a payload converter of proto messages, so it
+// encrypts the sensitive fields of messages when it converts them to
+// payloads, and decrypts them when it converts payloads to messages.
+// The original messages aren't modified.
+func NewPayloadConverter(pc PayloadConverter, kp KeyProvider) PayloadConverter {
+	return &payloadConverter{pc, kp}
+}
+
+func (c *payloadConverter) ToPayload(value interface{}) (*commonpb.Payload, error) {
+	if m, ok := value.(proto.Message); ok && m.ProtoReflect().IsValid() {
+		m = proto.Clone(m)
+		if err := Encrypt(m, c.kp); err != nil {
+			return nil, err
+		}
+		value = m
+	}
+	return c.PayloadConverter.ToPayload(value)
+}
+
+func (c *payloadConverter) FromPayload(payload *commonpb.Payload, valuePtr interface{}) error {
+	if err := c.PayloadConverter.FromPayload(payload, valuePtr); err != nil {
+		return err
+	}
+
+	// The value pointer is either a message, or a pointer to a message.
+	m, ok := valuePtr.(proto.Message)
+	if !ok {
+		v := reflect.ValueOf(valuePtr)
+		if v.Kind() != reflect.Ptr || v.IsNil() {
+			return nil
+		}
+		if m, ok = v.Elem().Interface().(proto.Message); !ok {
+			return nil
+		}
+	}
+	if !m.ProtoReflect().IsValid() {
+		return nil
+	}
+	return Decrypt(m, c.kp)
+}
@@ -0,0 +1,242 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package sensitive encrypts and decrypts the values of proto message fields
+// which are annotated with (temporal.sensitive), so they don't appear in
+// plaintext in Temporal payloads. Generated data converters use it via
+// [NewPayloadConverter], with a [KeyProvider] which the application supplies.
+//
+// Values are encrypted with AES-GCM. Encrypted strings are base64-encoded,
+// and both strings and bytes have a prefix which identifies them as encrypted,
+// so encryption is idempotent, and plaintext values (e.g. in payloads which
+// were created before a field became sensitive) are decrypted as is.
+package sensitive
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+const stringPrefix = "temporal-sensitive:v1:"
+
+var bytesPrefix = []byte("\x00" + stringPrefix)
+
+// KeyProvider provides AES keys (16, 24 or 32 bytes long) by ID. The ID of
+// the encryption key is stored with each encrypted value, so keys can be
+// rotated while older values are still decryptable.
+type KeyProvider interface {
+	// CurrentKeyID returns the ID of the key which encrypts new values.
+	CurrentKeyID() (string, error)
+	// Key returns the key with the given ID.
+	Key(id string) ([]byte, error)
+}
+
+// MemoryKeyProvider is a [KeyProvider] which keeps its keys in memory,
+// e.g. for tests.
+type MemoryKeyProvider struct {
+	CurrentID string
+	Keys      map[string][]byte
+}
+
+func (p *MemoryKeyProvider) CurrentKeyID() (string, error) {
+	if _, ok := p.Keys[p.CurrentID]; !ok {
+		return "", fmt.Errorf("unknown current key ID %q", p.CurrentID)
+	}
+	return p.CurrentID, nil
+}
+
+func (p *MemoryKeyProvider) Key(id string) ([]byte, error) {
+	k, ok := p.Keys[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown key ID %q", id)
+	}
+	return k, nil
+}
+
+// IsSensitive reports whether the given field is annotated with
+// (temporal.sensitive).
+func IsSensitive(fd protoreflect.FieldDescriptor) bool {
+	opts := fd.Options()
+	if opts == nil {
+		return false
+	}
+	return proto.GetExtension(opts, workerpb.E_Sensitive).(bool)
+}
+
+// Encrypt encrypts in place the sensitive fields of the given message,
+// including in nested messages.
+func Encrypt(m proto.Message, kp KeyProvider) error {
+	return transform(m.ProtoReflect(), func(b []byte) ([]byte, error) {
+		return seal(b, kp)
+	})
+}
+
+// Decrypt decrypts in place the sensitive fields of the given message,
+// including in nested messages.
+func Decrypt(m proto.Message, kp KeyProvider) error {
+	return transform(m.ProtoReflect(), func(b []byte) ([]byte, error) {
+		return open(b, kp)
+	})
+}
+
+// transform applies the given function to the values of all the sensitive
+// fields in the given message and its nested messages. The function receives
+// and returns values with the bytesPrefix, if they're encrypted.
+func transform(m protoreflect.Message, f func([]byte) ([]byte, error)) error {
+	var fields []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, fd)
+		return true
+	})
+
+	for _, fd := range fields {
+		var err error
+		switch {
+		case IsSensitive(fd) && fd.IsList():
+			list := m.Mutable(fd).List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				var v protoreflect.Value
+				if v, err = transformValue(fd, list.Get(i), f); err == nil {
+					list.Set(i, v)
+				}
+			}
+		case IsSensitive(fd):
+			var v protoreflect.Value
+			if v, err = transformValue(fd, m.Get(fd), f); err == nil {
+				m.Set(fd, v)
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			m.Get(fd).Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				err = transform(v.Message(), f)
+				return err == nil
+			})
+		case fd.IsList() && fd.Message() != nil:
+			list := m.Get(fd).List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = transform(list.Get(i).Message(), f)
+			}
+		case fd.Message() != nil:
+			err = transform(m.Mutable(fd).Message(), f)
+		}
+		if err != nil {
+			return fmt.Errorf("field %s: %w", fd.FullName(), err)
+		}
+	}
+	return nil
+}
+
+func transformValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, f func([]byte) ([]byte, error)) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.BytesKind:
+		b, err := f(v.Bytes())
+		return protoreflect.ValueOfBytes(b), err
+	case protoreflect.StringKind:
+		s := v.String()
+		if strings.HasPrefix(s, stringPrefix) {
+			b, err := base64.StdEncoding.DecodeString(s[len(stringPrefix):])
+			if err != nil {
+				return v, err
+			}
+			s = string(append(append([]byte{}, bytesPrefix...), b...))
+		}
+		b, err := f([]byte(s))
+		if bytes.HasPrefix(b, bytesPrefix) {
+			return protoreflect.ValueOfString(stringPrefix + base64.StdEncoding.EncodeToString(b[len(bytesPrefix):])), err
+		}
+		return protoreflect.ValueOfString(string(b)), err
+	default:
+		return v, fmt.Errorf("unsupported sensitive field kind %s", fd.Kind())
+	}
+}
+
+// seal encrypts a plaintext value, if it isn't encrypted already. The result
+// is: bytesPrefix, key ID length (1 byte), key ID, nonce, sealed plaintext.
+func seal(plaintext []byte, kp KeyProvider) ([]byte, error) {
+	if bytes.HasPrefix(plaintext, bytesPrefix) {
+		return plaintext, nil
+	}
+	id, err := kp.CurrentKeyID()
+	if err != nil {
+		return nil, err
+	}
+	if len(id) > 255 {
+		return nil, fmt.Errorf("key ID %q is too long", id)
+	}
+	aead, err := newAEAD(id, kp)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	out := append(append([]byte{}, bytesPrefix...), byte(len(id)))
+	out = append(append(out, id...), nonce...)
+	return aead.Seal(out, nonce, plaintext, []byte(id)), nil
+}
+
+// open decrypts an encrypted value, or returns a plaintext value as is.
+func open(ciphertext []byte, kp KeyProvider) ([]byte, error) {
+	if !bytes.HasPrefix(ciphertext, bytesPrefix) {
+		return ciphertext, nil
+	}
+	b := ciphertext[len(bytesPrefix):]
+	if len(b) == 0 || len(b) < 1+int(b[0]) {
+		return nil, errors.New("malformed encrypted value")
+	}
+	id := string(b[1 : 1+int(b[0])])
+	b = b[1+int(b[0]):]
+	aead, err := newAEAD(id, kp)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < aead.NonceSize() {
+		return nil, errors.New("malformed encrypted value")
+	}
+	return aead.Open(nil, b[:aead.NonceSize()], b[aead.NonceSize():], []byte(id))
+}
+
+func newAEAD(id string, kp KeyProvider) (cipher.AEAD, error) {
+	key, err := kp.Key(id)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,277 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package sensitive
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	workerpb "github.com/daabr/protoc-gen-temporal-go/proto/temporal"
+)
+
+// testMessage returns a populated message with this structure:
+//
+//	message Secret {
+//	  string name = 1 [(temporal.sensitive) = true];
+//	  bytes token = 2 [(temporal.sensitive) = true];
+//	  repeated string aliases = 3 [(temporal.sensitive) = true];
+//	  string public = 4;
+//	  Nested nested = 5;
+//	  repeated Nested list = 6;
+//	  map<string, Nested> by_key = 7;
+//	}
+//
+//	message Nested {
+//	  string ssn = 1 [(temporal.sensitive) = true];
+//	}
+func testMessage(t *testing.T) proto.Message {
+	t.Helper()
+
+	sensitive := &descriptorpb.FieldOptions{}
+	proto.SetExtension(sensitive, workerpb.E_Sensitive, true)
+	field := func(name string, number int32, label descriptorpb.FieldDescriptorProto_Label, typ descriptorpb.FieldDescriptorProto_Type, typeName string, opts *descriptorpb.FieldOptions) *descriptorpb.FieldDescriptorProto {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    label.Enum(),
+			Type:     typ.Enum(),
+			Options:  opts,
+		}
+		if typeName != "" {
+			f.TypeName = proto.String(typeName)
+		}
+		return f
+	}
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	repeated := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	str := descriptorpb.FieldDescriptorProto_TYPE_STRING
+	msg := descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("sensitive_test.proto"),
+		Package: proto.String("test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("Secret"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("name", 1, optional, str, "", sensitive),
+					field("token", 2, optional, descriptorpb.FieldDescriptorProto_TYPE_BYTES, "", sensitive),
+					field("aliases", 3, repeated, str, "", sensitive),
+					field("public", 4, optional, str, "", nil),
+					field("nested", 5, optional, msg, ".test.Nested", nil),
+					field("list", 6, repeated, msg, ".test.Nested", nil),
+					field("by_key", 7, repeated, msg, ".test.Secret.ByKeyEntry", nil),
+				},
+				NestedType: []*descriptorpb.DescriptorProto{
+					{
+						Name: proto.String("ByKeyEntry"),
+						Field: []*descriptorpb.FieldDescriptorProto{
+							field("key", 1, optional, str, "", nil),
+							field("value", 2, optional, msg, ".test.Nested", nil),
+						},
+						Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+					},
+				},
+			},
+			{
+				Name: proto.String("Nested"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("ssn", 1, optional, str, "", sensitive),
+				},
+			},
+		},
+	}
+	fd, err := protodesc.NewFile(fdp, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret := fd.Messages().ByName("Secret")
+	nestedDesc := fd.Messages().ByName("Nested")
+	nested := func(ssn string) protoreflect.Value {
+		n := dynamicpb.NewMessage(nestedDesc)
+		n.Set(nestedDesc.Fields().ByName("ssn"), protoreflect.ValueOfString(ssn))
+		return protoreflect.ValueOfMessage(n)
+	}
+
+	m := dynamicpb.NewMessage(secret)
+	fields := secret.Fields()
+	m.Set(fields.ByName("name"), protoreflect.ValueOfString("Alice"))
+	m.Set(fields.ByName("token"), protoreflect.ValueOfBytes([]byte("t0k3n")))
+	aliases := m.Mutable(fields.ByName("aliases")).List()
+	aliases.Append(protoreflect.ValueOfString("Alias-1"))
+	aliases.Append(protoreflect.ValueOfString("Alias-2"))
+	m.Set(fields.ByName("public"), protoreflect.ValueOfString("hello"))
+	m.Set(fields.ByName("nested"), nested("111-11-1111"))
+	m.Mutable(fields.ByName("list")).List().Append(nested("222-22-2222"))
+	m.Mutable(fields.ByName("by_key")).Map().Set(protoreflect.ValueOfString("k").MapKey(), nested("333-33-3333"))
+	return m
+}
+
+func testKeyProvider() *MemoryKeyProvider {
+	return &MemoryKeyProvider{
+		CurrentID: "key1",
+		Keys: map[string][]byte{
+			"key1": bytes.Repeat([]byte{1}, 32),
+			"key2": bytes.Repeat([]byte{2}, 16),
+		},
+	}
+}
+
+var plaintexts = []string{"Alice", "t0k3n", "Alias-1", "Alias-2", "111-11-1111", "222-22-2222", "333-33-3333"}
+
+func TestEncryptDecrypt(t *testing.T) {
+	want := testMessage(t)
+	m := proto.Clone(want)
+	kp := testKeyProvider()
+
+	if err := Encrypt(m, kp); err != nil {
+		t.Fatal(err)
+	}
+	b, err := proto.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range plaintexts {
+		if bytes.Contains(b, []byte(s)) {
+			t.Errorf("encrypted message contains %q", s)
+		}
+	}
+	if !bytes.Contains(b, []byte("hello")) {
+		t.Error("encrypted message doesn't contain the non-sensitive field")
+	}
+	name := m.ProtoReflect().Get(m.ProtoReflect().Descriptor().Fields().ByName("name")).String()
+	if !strings.HasPrefix(name, stringPrefix) {
+		t.Errorf("encrypted string = %q, want prefix %q", name, stringPrefix)
+	}
+
+	// Encryption is idempotent.
+	encrypted := proto.Clone(m)
+	if err := Encrypt(m, kp); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(m, encrypted) {
+		t.Error("encrypting an encrypted message changed it")
+	}
+
+	// Keys can be rotated.
+	kp.CurrentID = "key2"
+	if err := Decrypt(m, kp); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("Decrypt() = %v, want %v", m, want)
+	}
+
+	// Plaintext values are decrypted as is.
+	if err := Decrypt(m, kp); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("Decrypt() of plaintext = %v, want %v", m, want)
+	}
+}
+
+func TestDecryptUnknownKey(t *testing.T) {
+	m := testMessage(t)
+	kp := testKeyProvider()
+	if err := Encrypt(m, kp); err != nil {
+		t.Fatal(err)
+	}
+	delete(kp.Keys, "key1")
+	if err := Decrypt(m, kp); err == nil {
+		t.Error("Decrypt() with an unknown key succeeded, want an error")
+	}
+}
+
+// binaryConverter is a minimal proto payload converter, like the one in the
+// Temporal Go SDK, which this module doesn't depend on.
+type binaryConverter struct{}
+
+func (binaryConverter) ToPayload(value interface{}) (*commonpb.Payload, error) {
+	b, err := proto.Marshal(value.(proto.Message))
+	return &commonpb.Payload{Data: b}, err
+}
+
+func (binaryConverter) FromPayload(payload *commonpb.Payload, valuePtr interface{}) error {
+	m, ok := valuePtr.(proto.Message)
+	if !ok {
+		m = *valuePtr.(*proto.Message)
+	}
+	return proto.Unmarshal(payload.Data, m)
+}
+
+func (binaryConverter) ToString(payload *commonpb.Payload) string {
+	return string(payload.Data)
+}
+
+func (binaryConverter) Encoding() string {
+	return "binary/protobuf"
+}
+
+func TestPayloadConverter(t *testing.T) {
+	want := testMessage(t)
+	m := proto.Clone(want)
+	pc := NewPayloadConverter(binaryConverter{}, testKeyProvider())
+
+	p, err := pc.ToPayload(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(m, want) {
+		t.Error("ToPayload() modified its input")
+	}
+	for _, s := range plaintexts {
+		if bytes.Contains(p.Data, []byte(s)) {
+			t.Errorf("payload contains %q", s)
+		}
+	}
+
+	got := want.ProtoReflect().New().Interface()
+	if err := pc.FromPayload(p, got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("FromPayload(message) = %v, want %v", got, want)
+	}
+
+	got = want.ProtoReflect().New().Interface()
+	if err := pc.FromPayload(p, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("FromPayload(pointer) = %v, want %v", got, want)
+	}
+}
@@ -32,17 +32,66 @@ package workflows
 import (
 	context "context"
 	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
 	worker "go.temporal.io/sdk/worker"
 	workflow "go.temporal.io/sdk/workflow"
 	log "log"
 )
 
-func StartWorkerActivityWithEmptyOptions(c client.Client) {
-	taskQueue := "my-task-queue"
+// ActivityWithEmptyOptionsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ActivityWithEmptyOptionsWorkerOption func(*worker.Options)
+
+// WithActivityWithEmptyOptionsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithActivityWithEmptyOptionsBackgroundActivityContext(ctx context.Context) ActivityWithEmptyOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithActivityWithEmptyOptionsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithActivityWithEmptyOptionsInterceptors(interceptors ...interceptor.WorkerInterceptor) ActivityWithEmptyOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithActivityWithEmptyOptionsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithActivityWithEmptyOptionsOnFatalError(f func(error)) ActivityWithEmptyOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ActivityWithEmptyOptionsTaskQueue is the name of the task queue of the ActivityWithEmptyOptions worker.
+const ActivityWithEmptyOptionsTaskQueue = "my-task-queue"
+
+// NewWorkerActivityWithEmptyOptions creates a worker for the task queue of ActivityWithEmptyOptions,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterActivityWithEmptyOptions.
+func NewWorkerActivityWithEmptyOptions(c client.Client, runtimeOpts ...ActivityWithEmptyOptionsWorkerOption) worker.Worker {
 	opts := worker.Options{}
-	w := worker.New(c, taskQueue, opts)
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ActivityWithEmptyOptionsTaskQueue, opts)
+}
 
-	w.RegisterActivity(Foo)
+// RegisterActivityWithEmptyOptions registers the workflows and activities of ActivityWithEmptyOptions
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterActivityWithEmptyOptions(w worker.Registry, impl ActivityWithEmptyOptionsTemporalClient) {
+	w.RegisterActivity(impl.Foo)
+}
+
+// StartWorkerActivityWithEmptyOptions runs a worker which hosts only ActivityWithEmptyOptions,
+// until the process receives an interrupt signal.
+func StartWorkerActivityWithEmptyOptions(c client.Client, impl ActivityWithEmptyOptionsTemporalClient, runtimeOpts ...ActivityWithEmptyOptionsWorkerOption) {
+	w := NewWorkerActivityWithEmptyOptions(c, runtimeOpts...)
+	RegisterActivityWithEmptyOptions(w, impl)
 
 	if err := w.Run(worker.InterruptCh()); err != nil {
 		log.Fatalln("Failed to start Temporal worker:", err)
@@ -59,6 +108,10 @@ type activityWithEmptyOptionsTemporalClient struct {
 }
 
 func NewActivityWithEmptyOptionsTemporalClient(c client.Client) *ActivityWithEmptyOptionsTemporalClient {
+	return newActivityWithEmptyOptionsTemporalClient(c)
+}
+
+func newActivityWithEmptyOptionsTemporalClient(c client.Client) *activityWithEmptyOptionsTemporalClient {
 	return &activityWithEmptyOptionsTemporalClient{c}
 }
 
@@ -68,7 +121,9 @@ func NewActivityWithEmptyOptionsTemporalClient(c client.Client) *ActivityWithEmp
 // Future to interact with it until completion. For more information, see
 // https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
 func (c *activityWithEmptyOptionsTemporalClient) StartActivityActivityWithEmptyOptionsFoo(ctx workflow.Context, in *FooInput) workflow.Future {
-	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{})
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue: "my-task-queue",
+	})
 	return workflow.ExecuteActivity(ctx, c.Foo, in)
 }
 
@@ -78,7 +133,9 @@ func (c *activityWithEmptyOptionsTemporalClient) StartActivityActivityWithEmptyO
 // completion, and returns the output/error results. For more information, see
 // https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
 func (c *activityWithEmptyOptionsTemporalClient) ExecuteActivityActivityWithEmptyOptionsFoo(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
-	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{})
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue: "my-task-queue",
+	})
 	var out *FooOutput
 	err := workflow.ExecuteActivity(ctx, c.Foo, in).Get(ctx, &out)
 	return out, err
@@ -0,0 +1,207 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_large_payloads.proto
+
+package claimcheck
+
+import (
+	context "context"
+	claimcheck "github.com/daabr/protoc-gen-temporal-go/claimcheck"
+	client "go.temporal.io/sdk/client"
+	converter "go.temporal.io/sdk/converter"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+)
+
+// ServiceWithLargePayloadsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithLargePayloadsWorkerOption func(*worker.Options)
+
+// WithServiceWithLargePayloadsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithLargePayloadsBackgroundActivityContext(ctx context.Context) ServiceWithLargePayloadsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithLargePayloadsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithLargePayloadsInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithLargePayloadsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithLargePayloadsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithLargePayloadsOnFatalError(f func(error)) ServiceWithLargePayloadsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithLargePayloadsTaskQueue is the name of the task queue of the ServiceWithLargePayloads worker.
+const ServiceWithLargePayloadsTaskQueue = "my-task-queue"
+
+// NewWorkerServiceWithLargePayloads creates a worker for the task queue of ServiceWithLargePayloads,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithLargePayloads.
+func NewWorkerServiceWithLargePayloads(c client.Client, runtimeOpts ...ServiceWithLargePayloadsWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithLargePayloadsTaskQueue, opts)
+}
+
+// RegisterServiceWithLargePayloads registers the workflows and activities of ServiceWithLargePayloads
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithLargePayloads(w worker.Registry, impl ServiceWithLargePayloadsTemporalClient) {
+	w.RegisterWorkflow(impl.Import)
+}
+
+// StartWorkerServiceWithLargePayloads runs a worker which hosts only ServiceWithLargePayloads,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithLargePayloads(c client.Client, impl ServiceWithLargePayloadsTemporalClient, runtimeOpts ...ServiceWithLargePayloadsWorkerOption) {
+	w := NewWorkerServiceWithLargePayloads(c, runtimeOpts...)
+	RegisterServiceWithLargePayloads(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// ServiceWithLargePayloadsLargePayloadThreshold is the size threshold (in bytes) of large payloads of ServiceWithLargePayloads.
+const ServiceWithLargePayloadsLargePayloadThreshold = 1048576
+
+// ServiceWithLargePayloadsLargePayloadDataConverter wraps the given data converter, so it stores payloads
+// which are larger than ServiceWithLargePayloadsLargePayloadThreshold in the given blob store, and
+// replaces them with references. Use it in both clients and workers of ServiceWithLargePayloads.
+func ServiceWithLargePayloadsLargePayloadDataConverter(dc converter.DataConverter, store claimcheck.BlobStore) converter.DataConverter {
+	return converter.NewCodecDataConverter(dc, claimcheck.NewCodec(store, ServiceWithLargePayloadsLargePayloadThreshold))
+}
+
+// DialServiceWithLargePayloads creates a client with the data converter of ServiceWithLargePayloads if the
+// given options don't specify a data converter: converter.GetDefaultDataConverter(),
+// wrapped by ServiceWithLargePayloadsLargePayloadDataConverter with the given blob store.
+// Use it instead of client.Dial to create the client which is passed to the
+// other generated functions of ServiceWithLargePayloads.
+func DialServiceWithLargePayloads(opts client.Options, store claimcheck.BlobStore) (client.Client, error) {
+	if opts.DataConverter == nil {
+		opts.DataConverter = ServiceWithLargePayloadsLargePayloadDataConverter(converter.GetDefaultDataConverter(), store)
+	}
+	return client.Dial(opts)
+}
+
+type ServiceWithLargePayloadsTemporalClient interface {
+	// Import workflow, whose input may be large.
+	Import(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+}
+
+type serviceWithLargePayloadsTemporalClient struct {
+	t client.Client
+}
+
+func NewServiceWithLargePayloadsTemporalClient(c client.Client) *ServiceWithLargePayloadsTemporalClient {
+	return newServiceWithLargePayloadsTemporalClient(c)
+}
+
+func newServiceWithLargePayloadsTemporalClient(c client.Client) *serviceWithLargePayloadsTemporalClient {
+	return &serviceWithLargePayloadsTemporalClient{c}
+}
+
+// Import workflow, whose input may be large.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithLargePayloadsTemporalClient) StartWorkflowServiceWithLargePayloadsImport(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Import, in)
+}
+
+// Import workflow, whose input may be large.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithLargePayloadsTemporalClient) ExecuteWorkflowServiceWithLargePayloadsImport(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Import, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Import workflow, whose input may be large.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithLargePayloadsTemporalClient) StartChildWorkflowServiceWithLargePayloadsImport(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Import, in)
+}
+
+// Import workflow, whose input may be large.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithLargePayloadsTemporalClient) ExecuteChildWorkflowServiceWithLargePayloadsImport(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Import, in).Get(ctx, &out)
+	return out, err
+}
+
+// ContinueAsNewServiceWithLargePayloadsImport returns an error which ends the current run of the Import
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithLargePayloadsImport(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Import", in)
+}
@@ -0,0 +1,47 @@
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_cli.proto
+
+// Command servicewithcli starts and inspects the workflows of ServiceWithCli, with
+// inputs and outputs in protojson. Run it without arguments for usage details.
+package main
+
+import (
+	context "context"
+	flag "flag"
+	fmt "fmt"
+	cli "github.com/daabr/protoc-gen-temporal-go/testdata/cli"
+	client "go.temporal.io/sdk/client"
+	log "log"
+	os "os"
+)
+
+func main() {
+	address := flag.String("address", client.DefaultHostPort, "host:port of the Temporal frontend service")
+	namespace := flag.String("namespace", client.DefaultNamespace, "Temporal namespace")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), cli.ServiceWithCliCLIUsage)
+		fmt.Fprintln(flag.CommandLine.Output(), "\nFlags:")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	c, err := cli.DialServiceWithCli(client.Options{HostPort: *address, Namespace: *namespace})
+	if err != nil {
+		log.Fatalln("Failed to connect to Temporal:", err)
+	}
+	defer c.Close()
+
+	ctx := context.Background()
+	if err := cli.RunServiceWithCliCLI(ctx, c, flag.Args(), os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		c.Close()
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,1056 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_cli.proto
+
+package cli
+
+import (
+	context "context"
+	json "encoding/json"
+	errors "errors"
+	flag "flag"
+	fmt "fmt"
+	sensitive "github.com/daabr/protoc-gen-temporal-go/sensitive"
+	client "go.temporal.io/sdk/client"
+	converter "go.temporal.io/sdk/converter"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	io "io"
+	log "log"
+	os "os"
+	strings "strings"
+	time "time"
+)
+
+// ServiceWithCliWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithCliWorkerOption func(*worker.Options)
+
+// WithServiceWithCliBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithCliBackgroundActivityContext(ctx context.Context) ServiceWithCliWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithCliInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithCliInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithCliWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithCliOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithCliOnFatalError(f func(error)) ServiceWithCliWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithCliTaskQueue is the name of the task queue of the ServiceWithCli worker.
+const ServiceWithCliTaskQueue = "my-task-queue"
+
+// NewWorkerServiceWithCli creates a worker for the task queue of ServiceWithCli,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithCli.
+func NewWorkerServiceWithCli(c client.Client, runtimeOpts ...ServiceWithCliWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithCliTaskQueue, opts)
+}
+
+// RegisterServiceWithCli registers the workflows and activities of ServiceWithCli
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithCli(w worker.Registry, impl ServiceWithCliTemporalClient) {
+	w.RegisterWorkflow(impl.Foo)
+	w.RegisterWorkflow(impl.Bar)
+	w.RegisterActivity(impl.Baz)
+}
+
+// StartWorkerServiceWithCli runs a worker which hosts only ServiceWithCli,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithCli(c client.Client, impl ServiceWithCliTemporalClient, runtimeOpts ...ServiceWithCliWorkerOption) {
+	w := NewWorkerServiceWithCli(c, runtimeOpts...)
+	RegisterServiceWithCli(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// ServiceWithCliDataConverter returns the data converter of ServiceWithCli, which encodes
+// proto messages as JSON (as specified in its proto definition), and other
+// values like the SDK's default data converter. It can still decode proto
+// messages in both encodings.
+func ServiceWithCliDataConverter() converter.DataConverter {
+	return converter.NewCompositeDataConverter(
+		converter.NewNilPayloadConverter(),
+		converter.NewByteSlicePayloadConverter(),
+		converter.NewProtoJSONPayloadConverter(),
+		converter.NewProtoPayloadConverter(),
+		converter.NewJSONPayloadConverter(),
+	)
+}
+
+// CheckServiceWithCliDataConverter returns an error if the given data converter doesn't encode
+// proto messages as JSON, like ServiceWithCliDataConverter.
+func CheckServiceWithCliDataConverter(dc converter.DataConverter) error {
+	p, err := dc.ToPayload(&emptypb.Empty{})
+	if err != nil {
+		return fmt.Errorf("incompatible data converter for ServiceWithCli: %w", err)
+	}
+	if e := string(p.Metadata[converter.MetadataEncoding]); e != converter.MetadataEncodingProtoJSON {
+		return fmt.Errorf("incompatible data converter for ServiceWithCli: proto messages are encoded as %q instead of %q", e, converter.MetadataEncodingProtoJSON)
+	}
+	return nil
+}
+
+// DialServiceWithCli creates a client with the data converter of ServiceWithCli if the
+// given options don't specify a data converter: ServiceWithCliDataConverter().
+// It returns an error if they specify a data converter with a different
+// encoding, instead of failing later in workflows and activities.
+// Use it instead of client.Dial to create the client which is passed to the
+// other generated functions of ServiceWithCli.
+func DialServiceWithCli(opts client.Options) (client.Client, error) {
+	if opts.DataConverter == nil {
+		opts.DataConverter = ServiceWithCliDataConverter()
+	} else if err := CheckServiceWithCliDataConverter(opts.DataConverter); err != nil {
+		return nil, err
+	}
+	return client.Dial(opts)
+}
+
+// ServiceWithCli has a generated command-line tool.
+type ServiceWithCliTemporalClient interface {
+	// Foo workflow.
+	Foo(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+	// Bar workflow, without input and output.
+	Bar(ctx workflow.Context) error
+	// Baz activity, which the command-line tool doesn't run.
+	Baz(ctx context.Context, in *FooInput) (*FooOutput, error)
+}
+
+type serviceWithCliTemporalClient struct {
+	t client.Client
+}
+
+// ServiceWithCli has a generated command-line tool.
+func NewServiceWithCliTemporalClient(c client.Client) *ServiceWithCliTemporalClient {
+	return newServiceWithCliTemporalClient(c)
+}
+
+func newServiceWithCliTemporalClient(c client.Client) *serviceWithCliTemporalClient {
+	return &serviceWithCliTemporalClient{c}
+}
+
+// Foo workflow.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithCliTemporalClient) StartWorkflowServiceWithCliFoo(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+}
+
+// Foo workflow.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithCliTemporalClient) ExecuteWorkflowServiceWithCliFoo(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Foo workflow.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithCliTemporalClient) StartChildWorkflowServiceWithCliFoo(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Foo, in)
+}
+
+// Foo workflow.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithCliTemporalClient) ExecuteChildWorkflowServiceWithCliFoo(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Foo, in).Get(ctx, &out)
+	return out, err
+}
+
+// Bar workflow, without input and output.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithCliTemporalClient) StartWorkflowServiceWithCliBar(ctx context.Context) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Bar)
+}
+
+// Bar workflow, without input and output.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithCliTemporalClient) ExecuteWorkflowServiceWithCliBar(ctx context.Context) error {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Bar)
+	if err != nil {
+		return err
+	}
+	return run.Get(ctx, nil)
+}
+
+// Bar workflow, without input and output.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithCliTemporalClient) StartChildWorkflowServiceWithCliBar(ctx workflow.Context) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Bar)
+}
+
+// Bar workflow, without input and output.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithCliTemporalClient) ExecuteChildWorkflowServiceWithCliBar(ctx workflow.Context) error {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Bar).Get(ctx, nil)
+}
+
+// Baz activity, which the command-line tool doesn't run.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithCliTemporalClient) StartActivityServiceWithCliBaz(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Baz, in)
+}
+
+// Baz activity, which the command-line tool doesn't run.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithCliTemporalClient) ExecuteActivityServiceWithCliBaz(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Baz, in).Get(ctx, &out)
+	return out, err
+}
+
+// Baz activity, which the command-line tool doesn't run.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithCliTemporalClient) StartLocalActivityServiceWithCliBaz(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Baz, in)
+}
+
+// Baz activity, which the command-line tool doesn't run.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithCliTemporalClient) ExecuteLocalActivityServiceWithCliBaz(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Baz, in).Get(ctx, &out)
+	return out, err
+}
+
+// ContinueAsNewServiceWithCliFoo returns an error which ends the current run of the Foo
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithCliFoo(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Foo", in)
+}
+
+// ContinueAsNewServiceWithCliBar returns an error which ends the current run of the Bar
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithCliBar(ctx workflow.Context) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Bar")
+}
+
+// ServiceWithCliCLIUsage describes the command-line interface of ServiceWithCli, see RunServiceWithCliCLI.
+const ServiceWithCliCLIUsage = `Usage: <command> [flags] <subcommand> [subcommand flags]
+
+Subcommands:
+  start <workflow> [-input <file>]           Start a workflow, and print its IDs
+  execute <workflow> [-input <file>]         Execute a workflow, and print its output
+  result <workflow> -id <id> [-run-id <id>]  Wait for a workflow's output, and print it
+  describe -id <id> [-run-id <id>]           Describe a workflow execution
+  cancel -id <id> [-run-id <id>]             Request to cancel a workflow execution
+  signal -id <id> [-run-id <id>] -name <signal> [-input <file>]
+  query <workflow> -id <id> [-run-id <id>] -name <query>
+
+Workflows of cli.ServiceWithCli:
+  Foo (input: cli.FooInput, output: cli.FooOutput)
+  Bar (input: google.protobuf.Empty, output: google.protobuf.Empty)
+
+Workflow inputs are protojson, read from a file or from stdin (the default,
+or "-"). Signal inputs are JSON, and are omitted unless -input is specified.
+Workflow outputs and query results are printed as protojson (queries must
+return the output message of the workflow), and other outputs as JSON.`
+
+// RunServiceWithCliCLI runs the command-line interface of ServiceWithCli (see ServiceWithCliCLIUsage)
+// with the given arguments, without the program name and its own flags. The
+// client should be created with the data converter of the workers, if any.
+func RunServiceWithCliCLI(ctx context.Context, c client.Client, args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(ServiceWithCliCLIUsage)
+	}
+	cmd, args := args[0], args[1:]
+	var wf string
+	switch cmd {
+	case "start", "execute", "result", "query":
+		if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+			return fmt.Errorf("%s: missing workflow name\n\n%s", cmd, ServiceWithCliCLIUsage)
+		}
+		wf, args = args[0], args[1:]
+	}
+
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	input := fs.String("input", "", "input file, or \"-\" for stdin")
+	id := fs.String("id", "", "workflow ID")
+	runID := fs.String("run-id", "", "workflow run ID (default: the latest run)")
+	name := fs.String("name", "", "signal or query name")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%s: %w\n\n%s", cmd, err, ServiceWithCliCLIUsage)
+	}
+	switch {
+	case *id == "" && cmd != "start" && cmd != "execute":
+		return fmt.Errorf("%s: missing workflow ID (-id)", cmd)
+	case *name == "" && (cmd == "signal" || cmd == "query"):
+		return fmt.Errorf("%s: missing name (-name)", cmd)
+	}
+
+	switch cmd {
+	case "start", "execute", "result", "query":
+		return runServiceWithCliCLIWorkflow(ctx, newServiceWithCliTemporalClient(c), cmd, wf, *input, *id, *runID, *name, stdin, stdout)
+	case "describe":
+		resp, err := c.DescribeWorkflowExecution(ctx, *id, *runID)
+		if err != nil {
+			return err
+		}
+		info := resp.GetWorkflowExecutionInfo()
+		return writeServiceWithCliCLIJSON(stdout, map[string]interface{}{
+			"workflow_id":    info.GetExecution().GetWorkflowId(),
+			"run_id":         info.GetExecution().GetRunId(),
+			"type":           info.GetType().GetName(),
+			"status":         info.GetStatus().String(),
+			"task_queue":     info.GetTaskQueue(),
+			"start_time":     info.GetStartTime(),
+			"close_time":     info.GetCloseTime(),
+			"history_length": info.GetHistoryLength(),
+		})
+	case "cancel":
+		return c.CancelWorkflow(ctx, *id, *runID)
+	case "signal":
+		var arg interface{}
+		if *input != "" {
+			b, err := readServiceWithCliCLIInput(*input, stdin)
+			if err != nil {
+				return err
+			}
+			if err := json.Unmarshal(b, &arg); err != nil {
+				return fmt.Errorf("invalid signal input: %w", err)
+			}
+		}
+		return c.SignalWorkflow(ctx, *id, *runID, *name, arg)
+	default:
+		return fmt.Errorf("unknown subcommand %q\n\n%s", cmd, ServiceWithCliCLIUsage)
+	}
+}
+
+func runServiceWithCliCLIWorkflow(ctx context.Context, c *serviceWithCliTemporalClient, cmd, wf, input, id, runID, query string, stdin io.Reader, stdout io.Writer) error {
+	switch wf {
+	case "Foo":
+		switch cmd {
+		case "start":
+			in := &FooInput{}
+			if err := readServiceWithCliCLIMessage(input, stdin, in); err != nil {
+				return err
+			}
+			run, err := c.StartWorkflowServiceWithCliFoo(ctx, in)
+			if err != nil {
+				return err
+			}
+			return writeServiceWithCliCLIJSON(stdout, map[string]string{
+				"workflow_id": run.GetID(),
+				"run_id":      run.GetRunID(),
+			})
+		case "execute":
+			in := &FooInput{}
+			if err := readServiceWithCliCLIMessage(input, stdin, in); err != nil {
+				return err
+			}
+			out, err := c.ExecuteWorkflowServiceWithCliFoo(ctx, in)
+			if err != nil {
+				return err
+			}
+			return writeServiceWithCliCLIOutput(stdout, out)
+		case "query":
+			v, err := c.t.QueryWorkflow(ctx, id, runID, query)
+			if err != nil {
+				return err
+			}
+			var out *FooOutput
+			if v.HasValue() {
+				if err := v.Get(&out); err != nil {
+					return err
+				}
+			}
+			return writeServiceWithCliCLIOutput(stdout, out)
+		default:
+			var out *FooOutput
+			if err := c.t.GetWorkflow(ctx, id, runID).Get(ctx, &out); err != nil {
+				return err
+			}
+			return writeServiceWithCliCLIOutput(stdout, out)
+		}
+	case "Bar":
+		switch cmd {
+		case "start":
+			run, err := c.StartWorkflowServiceWithCliBar(ctx)
+			if err != nil {
+				return err
+			}
+			return writeServiceWithCliCLIJSON(stdout, map[string]string{
+				"workflow_id": run.GetID(),
+				"run_id":      run.GetRunID(),
+			})
+		case "execute":
+			if err := c.ExecuteWorkflowServiceWithCliBar(ctx); err != nil {
+				return err
+			}
+			return writeServiceWithCliCLIOutput(stdout, &emptypb.Empty{})
+		case "query":
+			if _, err := c.t.QueryWorkflow(ctx, id, runID, query); err != nil {
+				return err
+			}
+			return writeServiceWithCliCLIOutput(stdout, &emptypb.Empty{})
+		default:
+			if err := c.t.GetWorkflow(ctx, id, runID).Get(ctx, nil); err != nil {
+				return err
+			}
+			return writeServiceWithCliCLIOutput(stdout, &emptypb.Empty{})
+		}
+	}
+	return fmt.Errorf("%s: unknown workflow %q\n\n%s", cmd, wf, ServiceWithCliCLIUsage)
+}
+
+func readServiceWithCliCLIInput(input string, stdin io.Reader) ([]byte, error) {
+	var b []byte
+	var err error
+	if input == "" || input == "-" {
+		b, err = io.ReadAll(stdin)
+	} else {
+		b, err = os.ReadFile(input)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input: %w", err)
+	}
+	return b, nil
+}
+
+func readServiceWithCliCLIMessage(input string, stdin io.Reader, m proto.Message) error {
+	b, err := readServiceWithCliCLIInput(input, stdin)
+	if err != nil {
+		return err
+	}
+	if err := protojson.Unmarshal(b, m); err != nil {
+		return fmt.Errorf("invalid input: %w", err)
+	}
+	return nil
+}
+
+func writeServiceWithCliCLIOutput(stdout io.Writer, m proto.Message) error {
+	b, err := protojson.MarshalOptions{Multiline: true}.Marshal(m)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(stdout, string(b))
+	return err
+}
+
+func writeServiceWithCliCLIJSON(stdout io.Writer, v interface{}) error {
+	e := json.NewEncoder(stdout)
+	e.SetIndent("", "  ")
+	return e.Encode(v)
+}
+
+// ServiceWithoutWorkflowsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithoutWorkflowsWorkerOption func(*worker.Options)
+
+// WithServiceWithoutWorkflowsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithoutWorkflowsBackgroundActivityContext(ctx context.Context) ServiceWithoutWorkflowsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithoutWorkflowsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithoutWorkflowsInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithoutWorkflowsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithoutWorkflowsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithoutWorkflowsOnFatalError(f func(error)) ServiceWithoutWorkflowsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithoutWorkflowsTaskQueue is the name of the task queue of the ServiceWithoutWorkflows worker.
+const ServiceWithoutWorkflowsTaskQueue = "other-task-queue"
+
+// NewWorkerServiceWithoutWorkflows creates a worker for the task queue of ServiceWithoutWorkflows,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithoutWorkflows.
+func NewWorkerServiceWithoutWorkflows(c client.Client, runtimeOpts ...ServiceWithoutWorkflowsWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithoutWorkflowsTaskQueue, opts)
+}
+
+// RegisterServiceWithoutWorkflows registers the workflows and activities of ServiceWithoutWorkflows
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithoutWorkflows(w worker.Registry, impl ServiceWithoutWorkflowsTemporalClient) {
+	w.RegisterActivity(impl.Qux)
+}
+
+// StartWorkerServiceWithoutWorkflows runs a worker which hosts only ServiceWithoutWorkflows,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithoutWorkflows(c client.Client, impl ServiceWithoutWorkflowsTemporalClient, runtimeOpts ...ServiceWithoutWorkflowsWorkerOption) {
+	w := NewWorkerServiceWithoutWorkflows(c, runtimeOpts...)
+	RegisterServiceWithoutWorkflows(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// ServiceWithoutWorkflows has no workflows, so it has no command-line tool.
+type ServiceWithoutWorkflowsTemporalClient interface {
+	Qux(ctx context.Context, in *FooInput) (*FooOutput, error)
+}
+
+type serviceWithoutWorkflowsTemporalClient struct {
+	t client.Client
+}
+
+// ServiceWithoutWorkflows has no workflows, so it has no command-line tool.
+func NewServiceWithoutWorkflowsTemporalClient(c client.Client) *ServiceWithoutWorkflowsTemporalClient {
+	return newServiceWithoutWorkflowsTemporalClient(c)
+}
+
+func newServiceWithoutWorkflowsTemporalClient(c client.Client) *serviceWithoutWorkflowsTemporalClient {
+	return &serviceWithoutWorkflowsTemporalClient{c}
+}
+
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithoutWorkflowsTemporalClient) StartActivityServiceWithoutWorkflowsQux(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "other-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Qux, in)
+}
+
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithoutWorkflowsTemporalClient) ExecuteActivityServiceWithoutWorkflowsQux(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "other-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Qux, in).Get(ctx, &out)
+	return out, err
+}
+
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithoutWorkflowsTemporalClient) StartLocalActivityServiceWithoutWorkflowsQux(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Qux, in)
+}
+
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithoutWorkflowsTemporalClient) ExecuteLocalActivityServiceWithoutWorkflowsQux(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Qux, in).Get(ctx, &out)
+	return out, err
+}
+
+// ServiceWithSensitiveCliWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithSensitiveCliWorkerOption func(*worker.Options)
+
+// WithServiceWithSensitiveCliBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithSensitiveCliBackgroundActivityContext(ctx context.Context) ServiceWithSensitiveCliWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithSensitiveCliInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithSensitiveCliInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithSensitiveCliWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithSensitiveCliOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithSensitiveCliOnFatalError(f func(error)) ServiceWithSensitiveCliWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithSensitiveCliTaskQueue is the name of the task queue of the ServiceWithSensitiveCli worker.
+const ServiceWithSensitiveCliTaskQueue = "sensitive-task-queue"
+
+// NewWorkerServiceWithSensitiveCli creates a worker for the task queue of ServiceWithSensitiveCli,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithSensitiveCli.
+func NewWorkerServiceWithSensitiveCli(c client.Client, runtimeOpts ...ServiceWithSensitiveCliWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithSensitiveCliTaskQueue, opts)
+}
+
+// RegisterServiceWithSensitiveCli registers the workflows and activities of ServiceWithSensitiveCli
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithSensitiveCli(w worker.Registry, impl ServiceWithSensitiveCliTemporalClient) {
+	w.RegisterWorkflow(impl.Qux)
+}
+
+// StartWorkerServiceWithSensitiveCli runs a worker which hosts only ServiceWithSensitiveCli,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithSensitiveCli(c client.Client, impl ServiceWithSensitiveCliTemporalClient, runtimeOpts ...ServiceWithSensitiveCliWorkerOption) {
+	w := NewWorkerServiceWithSensitiveCli(c, runtimeOpts...)
+	RegisterServiceWithSensitiveCli(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// ServiceWithSensitiveCliSensitiveDataConverter returns a data converter for ServiceWithSensitiveCli which encrypts
+// the sensitive fields of proto messages before they leave the client or worker,
+// and decrypts them on the way in, with keys from the given key provider.
+func ServiceWithSensitiveCliSensitiveDataConverter(kp sensitive.KeyProvider) converter.DataConverter {
+	return converter.NewCompositeDataConverter(
+		converter.NewNilPayloadConverter(),
+		converter.NewByteSlicePayloadConverter(),
+		sensitive.NewPayloadConverter(converter.NewProtoJSONPayloadConverter(), kp),
+		sensitive.NewPayloadConverter(converter.NewProtoPayloadConverter(), kp),
+		converter.NewJSONPayloadConverter(),
+	)
+}
+
+// DialServiceWithSensitiveCli creates a client with the data converter of ServiceWithSensitiveCli if the
+// given options don't specify a data converter: ServiceWithSensitiveCliSensitiveDataConverter(kp).
+// Use it instead of client.Dial to create the client which is passed to the
+// other generated functions of ServiceWithSensitiveCli.
+func DialServiceWithSensitiveCli(opts client.Options, kp sensitive.KeyProvider) (client.Client, error) {
+	if opts.DataConverter == nil {
+		opts.DataConverter = ServiceWithSensitiveCliSensitiveDataConverter(kp)
+	}
+	return client.Dial(opts)
+}
+
+// ServiceWithSensitiveCli has sensitive fields, so it has no generated
+// command, only a function to run its command-line interface.
+type ServiceWithSensitiveCliTemporalClient interface {
+	// Qux workflow, whose input is sensitive.
+	Qux(ctx workflow.Context, in *SecretInput) (*FooOutput, error)
+}
+
+type serviceWithSensitiveCliTemporalClient struct {
+	t client.Client
+}
+
+// ServiceWithSensitiveCli has sensitive fields, so it has no generated
+// command, only a function to run its command-line interface.
+func NewServiceWithSensitiveCliTemporalClient(c client.Client) *ServiceWithSensitiveCliTemporalClient {
+	return newServiceWithSensitiveCliTemporalClient(c)
+}
+
+func newServiceWithSensitiveCliTemporalClient(c client.Client) *serviceWithSensitiveCliTemporalClient {
+	return &serviceWithSensitiveCliTemporalClient{c}
+}
+
+// Qux workflow, whose input is sensitive.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithSensitiveCliTemporalClient) StartWorkflowServiceWithSensitiveCliQux(ctx context.Context, in *SecretInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "sensitive-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Qux, in)
+}
+
+// Qux workflow, whose input is sensitive.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithSensitiveCliTemporalClient) ExecuteWorkflowServiceWithSensitiveCliQux(ctx context.Context, in *SecretInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "sensitive-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Qux, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Qux workflow, whose input is sensitive.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithSensitiveCliTemporalClient) StartChildWorkflowServiceWithSensitiveCliQux(ctx workflow.Context, in *SecretInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "sensitive-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Qux, in)
+}
+
+// Qux workflow, whose input is sensitive.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithSensitiveCliTemporalClient) ExecuteChildWorkflowServiceWithSensitiveCliQux(ctx workflow.Context, in *SecretInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "sensitive-task-queue",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Qux, in).Get(ctx, &out)
+	return out, err
+}
+
+// ContinueAsNewServiceWithSensitiveCliQux returns an error which ends the current run of the Qux
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithSensitiveCliQux(ctx workflow.Context, in *SecretInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "sensitive-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Qux", in)
+}
+
+// ServiceWithSensitiveCliCLIUsage describes the command-line interface of ServiceWithSensitiveCli, see RunServiceWithSensitiveCliCLI.
+const ServiceWithSensitiveCliCLIUsage = `Usage: <command> [flags] <subcommand> [subcommand flags]
+
+Subcommands:
+  start <workflow> [-input <file>]           Start a workflow, and print its IDs
+  execute <workflow> [-input <file>]         Execute a workflow, and print its output
+  result <workflow> -id <id> [-run-id <id>]  Wait for a workflow's output, and print it
+  describe -id <id> [-run-id <id>]           Describe a workflow execution
+  cancel -id <id> [-run-id <id>]             Request to cancel a workflow execution
+  signal -id <id> [-run-id <id>] -name <signal> [-input <file>]
+  query <workflow> -id <id> [-run-id <id>] -name <query>
+
+Workflows of cli.ServiceWithSensitiveCli:
+  Qux (input: cli.SecretInput, output: cli.FooOutput)
+
+Workflow inputs are protojson, read from a file or from stdin (the default,
+or "-"). Signal inputs are JSON, and are omitted unless -input is specified.
+Workflow outputs and query results are printed as protojson (queries must
+return the output message of the workflow), and other outputs as JSON.`
+
+// RunServiceWithSensitiveCliCLI runs the command-line interface of ServiceWithSensitiveCli (see ServiceWithSensitiveCliCLIUsage)
+// with the given arguments, without the program name and its own flags. The
+// client should be created with the data converter of the workers, if any.
+//
+// There is no generated command for ServiceWithSensitiveCli, because it needs a key provider
+// or a blob store: call this function with a client from DialServiceWithSensitiveCli.
+func RunServiceWithSensitiveCliCLI(ctx context.Context, c client.Client, args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(ServiceWithSensitiveCliCLIUsage)
+	}
+	cmd, args := args[0], args[1:]
+	var wf string
+	switch cmd {
+	case "start", "execute", "result", "query":
+		if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+			return fmt.Errorf("%s: missing workflow name\n\n%s", cmd, ServiceWithSensitiveCliCLIUsage)
+		}
+		wf, args = args[0], args[1:]
+	}
+
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	input := fs.String("input", "", "input file, or \"-\" for stdin")
+	id := fs.String("id", "", "workflow ID")
+	runID := fs.String("run-id", "", "workflow run ID (default: the latest run)")
+	name := fs.String("name", "", "signal or query name")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%s: %w\n\n%s", cmd, err, ServiceWithSensitiveCliCLIUsage)
+	}
+	switch {
+	case *id == "" && cmd != "start" && cmd != "execute":
+		return fmt.Errorf("%s: missing workflow ID (-id)", cmd)
+	case *name == "" && (cmd == "signal" || cmd == "query"):
+		return fmt.Errorf("%s: missing name (-name)", cmd)
+	}
+
+	switch cmd {
+	case "start", "execute", "result", "query":
+		return runServiceWithSensitiveCliCLIWorkflow(ctx, newServiceWithSensitiveCliTemporalClient(c), cmd, wf, *input, *id, *runID, *name, stdin, stdout)
+	case "describe":
+		resp, err := c.DescribeWorkflowExecution(ctx, *id, *runID)
+		if err != nil {
+			return err
+		}
+		info := resp.GetWorkflowExecutionInfo()
+		return writeServiceWithSensitiveCliCLIJSON(stdout, map[string]interface{}{
+			"workflow_id":    info.GetExecution().GetWorkflowId(),
+			"run_id":         info.GetExecution().GetRunId(),
+			"type":           info.GetType().GetName(),
+			"status":         info.GetStatus().String(),
+			"task_queue":     info.GetTaskQueue(),
+			"start_time":     info.GetStartTime(),
+			"close_time":     info.GetCloseTime(),
+			"history_length": info.GetHistoryLength(),
+		})
+	case "cancel":
+		return c.CancelWorkflow(ctx, *id, *runID)
+	case "signal":
+		var arg interface{}
+		if *input != "" {
+			b, err := readServiceWithSensitiveCliCLIInput(*input, stdin)
+			if err != nil {
+				return err
+			}
+			if err := json.Unmarshal(b, &arg); err != nil {
+				return fmt.Errorf("invalid signal input: %w", err)
+			}
+		}
+		return c.SignalWorkflow(ctx, *id, *runID, *name, arg)
+	default:
+		return fmt.Errorf("unknown subcommand %q\n\n%s", cmd, ServiceWithSensitiveCliCLIUsage)
+	}
+}
+
+func runServiceWithSensitiveCliCLIWorkflow(ctx context.Context, c *serviceWithSensitiveCliTemporalClient, cmd, wf, input, id, runID, query string, stdin io.Reader, stdout io.Writer) error {
+	switch wf {
+	case "Qux":
+		switch cmd {
+		case "start":
+			in := &SecretInput{}
+			if err := readServiceWithSensitiveCliCLIMessage(input, stdin, in); err != nil {
+				return err
+			}
+			run, err := c.StartWorkflowServiceWithSensitiveCliQux(ctx, in)
+			if err != nil {
+				return err
+			}
+			return writeServiceWithSensitiveCliCLIJSON(stdout, map[string]string{
+				"workflow_id": run.GetID(),
+				"run_id":      run.GetRunID(),
+			})
+		case "execute":
+			in := &SecretInput{}
+			if err := readServiceWithSensitiveCliCLIMessage(input, stdin, in); err != nil {
+				return err
+			}
+			out, err := c.ExecuteWorkflowServiceWithSensitiveCliQux(ctx, in)
+			if err != nil {
+				return err
+			}
+			return writeServiceWithSensitiveCliCLIOutput(stdout, out)
+		case "query":
+			v, err := c.t.QueryWorkflow(ctx, id, runID, query)
+			if err != nil {
+				return err
+			}
+			var out *FooOutput
+			if v.HasValue() {
+				if err := v.Get(&out); err != nil {
+					return err
+				}
+			}
+			return writeServiceWithSensitiveCliCLIOutput(stdout, out)
+		default:
+			var out *FooOutput
+			if err := c.t.GetWorkflow(ctx, id, runID).Get(ctx, &out); err != nil {
+				return err
+			}
+			return writeServiceWithSensitiveCliCLIOutput(stdout, out)
+		}
+	}
+	return fmt.Errorf("%s: unknown workflow %q\n\n%s", cmd, wf, ServiceWithSensitiveCliCLIUsage)
+}
+
+func readServiceWithSensitiveCliCLIInput(input string, stdin io.Reader) ([]byte, error) {
+	var b []byte
+	var err error
+	if input == "" || input == "-" {
+		b, err = io.ReadAll(stdin)
+	} else {
+		b, err = os.ReadFile(input)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input: %w", err)
+	}
+	return b, nil
+}
+
+func readServiceWithSensitiveCliCLIMessage(input string, stdin io.Reader, m proto.Message) error {
+	b, err := readServiceWithSensitiveCliCLIInput(input, stdin)
+	if err != nil {
+		return err
+	}
+	if err := protojson.Unmarshal(b, m); err != nil {
+		return fmt.Errorf("invalid input: %w", err)
+	}
+	return nil
+}
+
+func writeServiceWithSensitiveCliCLIOutput(stdout io.Writer, m proto.Message) error {
+	b, err := protojson.MarshalOptions{Multiline: true}.Marshal(m)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(stdout, string(b))
+	return err
+}
+
+func writeServiceWithSensitiveCliCLIJSON(stdout io.Writer, v interface{}) error {
+	e := json.NewEncoder(stdout)
+	e.SetIndent("", "  ")
+	return e.Encode(v)
+}
@@ -30,15 +30,66 @@
 package client
 
 import (
+	context "context"
 	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
 	worker "go.temporal.io/sdk/worker"
 	log "log"
 )
 
-func StartWorkerWorkerWithComments(c client.Client) {
-	taskQueue := "my-task-queue"
+// WorkerWithCommentsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type WorkerWithCommentsWorkerOption func(*worker.Options)
+
+// WithWorkerWithCommentsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithWorkerWithCommentsBackgroundActivityContext(ctx context.Context) WorkerWithCommentsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithWorkerWithCommentsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithWorkerWithCommentsInterceptors(interceptors ...interceptor.WorkerInterceptor) WorkerWithCommentsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithWorkerWithCommentsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithWorkerWithCommentsOnFatalError(f func(error)) WorkerWithCommentsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// WorkerWithCommentsTaskQueue is the name of the task queue of the WorkerWithComments worker.
+const WorkerWithCommentsTaskQueue = "my-task-queue"
+
+// NewWorkerWorkerWithComments creates a worker for the task queue of WorkerWithComments,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterWorkerWithComments.
+func NewWorkerWorkerWithComments(c client.Client, runtimeOpts ...WorkerWithCommentsWorkerOption) worker.Worker {
 	opts := worker.Options{}
-	w := worker.New(c, taskQueue, opts)
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, WorkerWithCommentsTaskQueue, opts)
+}
+
+// RegisterWorkerWithComments registers the workflows and activities of WorkerWithComments
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterWorkerWithComments(w worker.Registry, impl WorkerWithCommentsTemporalClient) {
+}
+
+// StartWorkerWorkerWithComments runs a worker which hosts only WorkerWithComments,
+// until the process receives an interrupt signal.
+func StartWorkerWorkerWithComments(c client.Client, impl WorkerWithCommentsTemporalClient, runtimeOpts ...WorkerWithCommentsWorkerOption) {
+	w := NewWorkerWorkerWithComments(c, runtimeOpts...)
+	RegisterWorkerWithComments(w, impl)
 
 	if err := w.Run(worker.InterruptCh()); err != nil {
 		log.Fatalln("Failed to start Temporal worker:", err)
@@ -56,5 +107,9 @@ type workerWithCommentsTemporalClient struct {
 
 // WorkerWithComments leading comment.
 func NewWorkerWithCommentsTemporalClient(c client.Client) *WorkerWithCommentsTemporalClient {
+	return newWorkerWithCommentsTemporalClient(c)
+}
+
+func newWorkerWithCommentsTemporalClient(c client.Client) *workerWithCommentsTemporalClient {
 	return &workerWithCommentsTemporalClient{c}
 }
@@ -30,15 +30,66 @@
 package client
 
 import (
+	context "context"
 	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
 	worker "go.temporal.io/sdk/worker"
 	log "log"
 )
 
-func StartWorkerDeprecatedWorkerWithComments(c client.Client) {
-	taskQueue := "my-task-queue"
+// DeprecatedWorkerWithCommentsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type DeprecatedWorkerWithCommentsWorkerOption func(*worker.Options)
+
+// WithDeprecatedWorkerWithCommentsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithDeprecatedWorkerWithCommentsBackgroundActivityContext(ctx context.Context) DeprecatedWorkerWithCommentsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithDeprecatedWorkerWithCommentsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithDeprecatedWorkerWithCommentsInterceptors(interceptors ...interceptor.WorkerInterceptor) DeprecatedWorkerWithCommentsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithDeprecatedWorkerWithCommentsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithDeprecatedWorkerWithCommentsOnFatalError(f func(error)) DeprecatedWorkerWithCommentsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// DeprecatedWorkerWithCommentsTaskQueue is the name of the task queue of the DeprecatedWorkerWithComments worker.
+const DeprecatedWorkerWithCommentsTaskQueue = "my-task-queue"
+
+// NewWorkerDeprecatedWorkerWithComments creates a worker for the task queue of DeprecatedWorkerWithComments,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterDeprecatedWorkerWithComments.
+func NewWorkerDeprecatedWorkerWithComments(c client.Client, runtimeOpts ...DeprecatedWorkerWithCommentsWorkerOption) worker.Worker {
 	opts := worker.Options{}
-	w := worker.New(c, taskQueue, opts)
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, DeprecatedWorkerWithCommentsTaskQueue, opts)
+}
+
+// RegisterDeprecatedWorkerWithComments registers the workflows and activities of DeprecatedWorkerWithComments
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterDeprecatedWorkerWithComments(w worker.Registry, impl DeprecatedWorkerWithCommentsTemporalClient) {
+}
+
+// StartWorkerDeprecatedWorkerWithComments runs a worker which hosts only DeprecatedWorkerWithComments,
+// until the process receives an interrupt signal.
+func StartWorkerDeprecatedWorkerWithComments(c client.Client, impl DeprecatedWorkerWithCommentsTemporalClient, runtimeOpts ...DeprecatedWorkerWithCommentsWorkerOption) {
+	w := NewWorkerDeprecatedWorkerWithComments(c, runtimeOpts...)
+	RegisterDeprecatedWorkerWithComments(w, impl)
 
 	if err := w.Run(worker.InterruptCh()); err != nil {
 		log.Fatalln("Failed to start Temporal worker:", err)
@@ -60,13 +111,66 @@ type deprecatedWorkerWithCommentsTemporalClient struct {
 //
 // Deprecated: Do not use.
 func NewDeprecatedWorkerWithCommentsTemporalClient(c client.Client) *DeprecatedWorkerWithCommentsTemporalClient {
+	return newDeprecatedWorkerWithCommentsTemporalClient(c)
+}
+
+func newDeprecatedWorkerWithCommentsTemporalClient(c client.Client) *deprecatedWorkerWithCommentsTemporalClient {
 	return &deprecatedWorkerWithCommentsTemporalClient{c}
 }
 
-func StartWorkerDeprecatedWorkerWithoutComments(c client.Client) {
-	taskQueue := "my-task-queue"
+// DeprecatedWorkerWithoutCommentsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type DeprecatedWorkerWithoutCommentsWorkerOption func(*worker.Options)
+
+// WithDeprecatedWorkerWithoutCommentsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithDeprecatedWorkerWithoutCommentsBackgroundActivityContext(ctx context.Context) DeprecatedWorkerWithoutCommentsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithDeprecatedWorkerWithoutCommentsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithDeprecatedWorkerWithoutCommentsInterceptors(interceptors ...interceptor.WorkerInterceptor) DeprecatedWorkerWithoutCommentsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithDeprecatedWorkerWithoutCommentsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithDeprecatedWorkerWithoutCommentsOnFatalError(f func(error)) DeprecatedWorkerWithoutCommentsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// DeprecatedWorkerWithoutCommentsTaskQueue is the name of the task queue of the DeprecatedWorkerWithoutComments worker.
+const DeprecatedWorkerWithoutCommentsTaskQueue = "my-task-queue"
+
+// NewWorkerDeprecatedWorkerWithoutComments creates a worker for the task queue of DeprecatedWorkerWithoutComments,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterDeprecatedWorkerWithoutComments.
+func NewWorkerDeprecatedWorkerWithoutComments(c client.Client, runtimeOpts ...DeprecatedWorkerWithoutCommentsWorkerOption) worker.Worker {
 	opts := worker.Options{}
-	w := worker.New(c, taskQueue, opts)
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, DeprecatedWorkerWithoutCommentsTaskQueue, opts)
+}
+
+// RegisterDeprecatedWorkerWithoutComments registers the workflows and activities of DeprecatedWorkerWithoutComments
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterDeprecatedWorkerWithoutComments(w worker.Registry, impl DeprecatedWorkerWithoutCommentsTemporalClient) {
+}
+
+// StartWorkerDeprecatedWorkerWithoutComments runs a worker which hosts only DeprecatedWorkerWithoutComments,
+// until the process receives an interrupt signal.
+func StartWorkerDeprecatedWorkerWithoutComments(c client.Client, impl DeprecatedWorkerWithoutCommentsTemporalClient, runtimeOpts ...DeprecatedWorkerWithoutCommentsWorkerOption) {
+	w := NewWorkerDeprecatedWorkerWithoutComments(c, runtimeOpts...)
+	RegisterDeprecatedWorkerWithoutComments(w, impl)
 
 	if err := w.Run(worker.InterruptCh()); err != nil {
 		log.Fatalln("Failed to start Temporal worker:", err)
@@ -83,5 +187,9 @@ type deprecatedWorkerWithoutCommentsTemporalClient struct {
 
 // Deprecated: Do not use.
 func NewDeprecatedWorkerWithoutCommentsTemporalClient(c client.Client) *DeprecatedWorkerWithoutCommentsTemporalClient {
+	return newDeprecatedWorkerWithoutCommentsTemporalClient(c)
+}
+
+func newDeprecatedWorkerWithoutCommentsTemporalClient(c client.Client) *deprecatedWorkerWithoutCommentsTemporalClient {
 	return &deprecatedWorkerWithoutCommentsTemporalClient{c}
 }
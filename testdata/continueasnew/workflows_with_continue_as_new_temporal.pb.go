@@ -0,0 +1,324 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: workflows_with_continue_as_new.proto
+
+package continueasnew
+
+import (
+	context "context"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+	time "time"
+)
+
+// WorkflowsWithContinueAsNewWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type WorkflowsWithContinueAsNewWorkerOption func(*worker.Options)
+
+// WithWorkflowsWithContinueAsNewBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithWorkflowsWithContinueAsNewBackgroundActivityContext(ctx context.Context) WorkflowsWithContinueAsNewWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithWorkflowsWithContinueAsNewInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithWorkflowsWithContinueAsNewInterceptors(interceptors ...interceptor.WorkerInterceptor) WorkflowsWithContinueAsNewWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithWorkflowsWithContinueAsNewOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithWorkflowsWithContinueAsNewOnFatalError(f func(error)) WorkflowsWithContinueAsNewWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// WorkflowsWithContinueAsNewTaskQueue is the name of the task queue of the WorkflowsWithContinueAsNew worker.
+const WorkflowsWithContinueAsNewTaskQueue = "my-task-queue"
+
+// NewWorkerWorkflowsWithContinueAsNew creates a worker for the task queue of WorkflowsWithContinueAsNew,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterWorkflowsWithContinueAsNew.
+func NewWorkerWorkflowsWithContinueAsNew(c client.Client, runtimeOpts ...WorkflowsWithContinueAsNewWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, WorkflowsWithContinueAsNewTaskQueue, opts)
+}
+
+// RegisterWorkflowsWithContinueAsNew registers the workflows and activities of WorkflowsWithContinueAsNew
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterWorkflowsWithContinueAsNew(w worker.Registry, impl WorkflowsWithContinueAsNewTemporalClient) {
+	w.RegisterWorkflow(impl.Poll)
+	w.RegisterWorkflow(impl.Loop)
+	w.RegisterActivity(impl.Noop)
+}
+
+// StartWorkerWorkflowsWithContinueAsNew runs a worker which hosts only WorkflowsWithContinueAsNew,
+// until the process receives an interrupt signal.
+func StartWorkerWorkflowsWithContinueAsNew(c client.Client, impl WorkflowsWithContinueAsNewTemporalClient, runtimeOpts ...WorkflowsWithContinueAsNewWorkerOption) {
+	w := NewWorkerWorkflowsWithContinueAsNew(c, runtimeOpts...)
+	RegisterWorkflowsWithContinueAsNew(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+type WorkflowsWithContinueAsNewTemporalClient interface {
+	// Poll workflow, which continues as new with its proto options.
+	Poll(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+	// Loop workflow, which continues as new only when its history is long.
+	Loop(ctx workflow.Context) error
+	// Noop activity, without a Continue-As-New helper.
+	Noop(ctx context.Context, in *FooInput) (*FooOutput, error)
+}
+
+type workflowsWithContinueAsNewTemporalClient struct {
+	t client.Client
+}
+
+func NewWorkflowsWithContinueAsNewTemporalClient(c client.Client) *WorkflowsWithContinueAsNewTemporalClient {
+	return newWorkflowsWithContinueAsNewTemporalClient(c)
+}
+
+func newWorkflowsWithContinueAsNewTemporalClient(c client.Client) *workflowsWithContinueAsNewTemporalClient {
+	return &workflowsWithContinueAsNewTemporalClient{c}
+}
+
+// Poll workflow, which continues as new with its proto options.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *workflowsWithContinueAsNewTemporalClient) StartWorkflowWorkflowsWithContinueAsNewPoll(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue:           "my-task-queue",
+		WorkflowRunTimeout:  time.Duration(3600 * float64(time.Second)),
+		WorkflowTaskTimeout: time.Duration(10 * float64(time.Second)),
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Poll, in)
+}
+
+// Poll workflow, which continues as new with its proto options.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *workflowsWithContinueAsNewTemporalClient) ExecuteWorkflowWorkflowsWithContinueAsNewPoll(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue:           "my-task-queue",
+		WorkflowRunTimeout:  time.Duration(3600 * float64(time.Second)),
+		WorkflowTaskTimeout: time.Duration(10 * float64(time.Second)),
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Poll, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Poll workflow, which continues as new with its proto options.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *workflowsWithContinueAsNewTemporalClient) StartChildWorkflowWorkflowsWithContinueAsNewPoll(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue:           "my-task-queue",
+		WorkflowRunTimeout:  time.Duration(3600 * float64(time.Second)),
+		WorkflowTaskTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Poll, in)
+}
+
+// Poll workflow, which continues as new with its proto options.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *workflowsWithContinueAsNewTemporalClient) ExecuteChildWorkflowWorkflowsWithContinueAsNewPoll(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue:           "my-task-queue",
+		WorkflowRunTimeout:  time.Duration(3600 * float64(time.Second)),
+		WorkflowTaskTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Poll, in).Get(ctx, &out)
+	return out, err
+}
+
+// Loop workflow, which continues as new only when its history is long.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *workflowsWithContinueAsNewTemporalClient) StartWorkflowWorkflowsWithContinueAsNewLoop(ctx context.Context) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "loops",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Loop)
+}
+
+// Loop workflow, which continues as new only when its history is long.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *workflowsWithContinueAsNewTemporalClient) ExecuteWorkflowWorkflowsWithContinueAsNewLoop(ctx context.Context) error {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "loops",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Loop)
+	if err != nil {
+		return err
+	}
+	return run.Get(ctx, nil)
+}
+
+// Loop workflow, which continues as new only when its history is long.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *workflowsWithContinueAsNewTemporalClient) StartChildWorkflowWorkflowsWithContinueAsNewLoop(ctx workflow.Context) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "loops",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Loop)
+}
+
+// Loop workflow, which continues as new only when its history is long.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *workflowsWithContinueAsNewTemporalClient) ExecuteChildWorkflowWorkflowsWithContinueAsNewLoop(ctx workflow.Context) error {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "loops",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Loop).Get(ctx, nil)
+}
+
+// Noop activity, without a Continue-As-New helper.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *workflowsWithContinueAsNewTemporalClient) StartActivityWorkflowsWithContinueAsNewNoop(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Noop, in)
+}
+
+// Noop activity, without a Continue-As-New helper.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *workflowsWithContinueAsNewTemporalClient) ExecuteActivityWorkflowsWithContinueAsNewNoop(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Noop, in).Get(ctx, &out)
+	return out, err
+}
+
+// Noop activity, without a Continue-As-New helper.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *workflowsWithContinueAsNewTemporalClient) StartLocalActivityWorkflowsWithContinueAsNewNoop(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Noop, in)
+}
+
+// Noop activity, without a Continue-As-New helper.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *workflowsWithContinueAsNewTemporalClient) ExecuteLocalActivityWorkflowsWithContinueAsNewNoop(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Noop, in).Get(ctx, &out)
+	return out, err
+}
+
+// ContinueAsNewWorkflowsWithContinueAsNewPoll returns an error which ends the current run of the Poll
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewWorkflowsWithContinueAsNewPoll(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	ctx = workflow.WithWorkflowRunTimeout(ctx, time.Duration(3600*float64(time.Second)))
+	ctx = workflow.WithWorkflowTaskTimeout(ctx, time.Duration(10*float64(time.Second)))
+	return workflow.NewContinueAsNewError(ctx, "Poll", in)
+}
+
+// ContinueAsNewWorkflowsWithContinueAsNewLoop returns an error which ends the current run of the Loop
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// It returns nil (i.e. the workflow should keep running) unless the workflow's
+// history has at least 10000 events.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewWorkflowsWithContinueAsNewLoop(ctx workflow.Context) error {
+	if workflow.GetInfo(ctx).GetCurrentHistoryLength() < 10000 {
+		return nil
+	}
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "loops")
+	return workflow.NewContinueAsNewError(ctx, "Loop")
+}
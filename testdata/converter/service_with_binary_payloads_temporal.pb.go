@@ -0,0 +1,228 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_binary_payloads.proto
+
+package converter
+
+import (
+	context "context"
+	fmt "fmt"
+	client "go.temporal.io/sdk/client"
+	converter "go.temporal.io/sdk/converter"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	log "log"
+)
+
+// ServiceWithBinaryPayloadsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithBinaryPayloadsWorkerOption func(*worker.Options)
+
+// WithServiceWithBinaryPayloadsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithBinaryPayloadsBackgroundActivityContext(ctx context.Context) ServiceWithBinaryPayloadsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithBinaryPayloadsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithBinaryPayloadsInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithBinaryPayloadsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithBinaryPayloadsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithBinaryPayloadsOnFatalError(f func(error)) ServiceWithBinaryPayloadsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithBinaryPayloadsTaskQueue is the name of the task queue of the ServiceWithBinaryPayloads worker.
+const ServiceWithBinaryPayloadsTaskQueue = "my-task-queue"
+
+// NewWorkerServiceWithBinaryPayloads creates a worker for the task queue of ServiceWithBinaryPayloads,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithBinaryPayloads.
+func NewWorkerServiceWithBinaryPayloads(c client.Client, runtimeOpts ...ServiceWithBinaryPayloadsWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithBinaryPayloadsTaskQueue, opts)
+}
+
+// RegisterServiceWithBinaryPayloads registers the workflows and activities of ServiceWithBinaryPayloads
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithBinaryPayloads(w worker.Registry, impl ServiceWithBinaryPayloadsTemporalClient) {
+	w.RegisterWorkflow(impl.Foo)
+}
+
+// StartWorkerServiceWithBinaryPayloads runs a worker which hosts only ServiceWithBinaryPayloads,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithBinaryPayloads(c client.Client, impl ServiceWithBinaryPayloadsTemporalClient, runtimeOpts ...ServiceWithBinaryPayloadsWorkerOption) {
+	w := NewWorkerServiceWithBinaryPayloads(c, runtimeOpts...)
+	RegisterServiceWithBinaryPayloads(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// ServiceWithBinaryPayloadsDataConverter returns the data converter of ServiceWithBinaryPayloads, which encodes
+// proto messages as binary (as specified in its proto definition), and other
+// values like the SDK's default data converter. It can still decode proto
+// messages in both encodings.
+func ServiceWithBinaryPayloadsDataConverter() converter.DataConverter {
+	return converter.NewCompositeDataConverter(
+		converter.NewNilPayloadConverter(),
+		converter.NewByteSlicePayloadConverter(),
+		converter.NewProtoPayloadConverter(),
+		converter.NewProtoJSONPayloadConverter(),
+		converter.NewJSONPayloadConverter(),
+	)
+}
+
+// CheckServiceWithBinaryPayloadsDataConverter returns an error if the given data converter doesn't encode
+// proto messages as binary, like ServiceWithBinaryPayloadsDataConverter.
+func CheckServiceWithBinaryPayloadsDataConverter(dc converter.DataConverter) error {
+	p, err := dc.ToPayload(&emptypb.Empty{})
+	if err != nil {
+		return fmt.Errorf("incompatible data converter for ServiceWithBinaryPayloads: %w", err)
+	}
+	if e := string(p.Metadata[converter.MetadataEncoding]); e != converter.MetadataEncodingProto {
+		return fmt.Errorf("incompatible data converter for ServiceWithBinaryPayloads: proto messages are encoded as %q instead of %q", e, converter.MetadataEncodingProto)
+	}
+	return nil
+}
+
+// DialServiceWithBinaryPayloads creates a client with the data converter of ServiceWithBinaryPayloads if the
+// given options don't specify a data converter: ServiceWithBinaryPayloadsDataConverter().
+// It returns an error if they specify a data converter with a different
+// encoding, instead of failing later in workflows and activities.
+// Use it instead of client.Dial to create the client which is passed to the
+// other generated functions of ServiceWithBinaryPayloads.
+func DialServiceWithBinaryPayloads(opts client.Options) (client.Client, error) {
+	if opts.DataConverter == nil {
+		opts.DataConverter = ServiceWithBinaryPayloadsDataConverter()
+	} else if err := CheckServiceWithBinaryPayloadsDataConverter(opts.DataConverter); err != nil {
+		return nil, err
+	}
+	return client.Dial(opts)
+}
+
+type ServiceWithBinaryPayloadsTemporalClient interface {
+	// Foo workflow.
+	Foo(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+}
+
+type serviceWithBinaryPayloadsTemporalClient struct {
+	t client.Client
+}
+
+func NewServiceWithBinaryPayloadsTemporalClient(c client.Client) *ServiceWithBinaryPayloadsTemporalClient {
+	return newServiceWithBinaryPayloadsTemporalClient(c)
+}
+
+func newServiceWithBinaryPayloadsTemporalClient(c client.Client) *serviceWithBinaryPayloadsTemporalClient {
+	return &serviceWithBinaryPayloadsTemporalClient{c}
+}
+
+// Foo workflow.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithBinaryPayloadsTemporalClient) StartWorkflowServiceWithBinaryPayloadsFoo(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+}
+
+// Foo workflow.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithBinaryPayloadsTemporalClient) ExecuteWorkflowServiceWithBinaryPayloadsFoo(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Foo workflow.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithBinaryPayloadsTemporalClient) StartChildWorkflowServiceWithBinaryPayloadsFoo(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Foo, in)
+}
+
+// Foo workflow.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithBinaryPayloadsTemporalClient) ExecuteChildWorkflowServiceWithBinaryPayloadsFoo(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Foo, in).Get(ctx, &out)
+	return out, err
+}
+
+// ContinueAsNewServiceWithBinaryPayloadsFoo returns an error which ends the current run of the Foo
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithBinaryPayloadsFoo(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Foo", in)
+}
@@ -0,0 +1,256 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_composed_converters.proto
+
+package converter
+
+import (
+	context "context"
+	fmt "fmt"
+	claimcheck "github.com/daabr/protoc-gen-temporal-go/claimcheck"
+	sensitive "github.com/daabr/protoc-gen-temporal-go/sensitive"
+	client "go.temporal.io/sdk/client"
+	converter "go.temporal.io/sdk/converter"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	log "log"
+)
+
+// ServiceWithComposedConvertersWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithComposedConvertersWorkerOption func(*worker.Options)
+
+// WithServiceWithComposedConvertersBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithComposedConvertersBackgroundActivityContext(ctx context.Context) ServiceWithComposedConvertersWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithComposedConvertersInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithComposedConvertersInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithComposedConvertersWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithComposedConvertersOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithComposedConvertersOnFatalError(f func(error)) ServiceWithComposedConvertersWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithComposedConvertersTaskQueue is the name of the task queue of the ServiceWithComposedConverters worker.
+const ServiceWithComposedConvertersTaskQueue = "composed-task-queue"
+
+// NewWorkerServiceWithComposedConverters creates a worker for the task queue of ServiceWithComposedConverters,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithComposedConverters.
+func NewWorkerServiceWithComposedConverters(c client.Client, runtimeOpts ...ServiceWithComposedConvertersWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithComposedConvertersTaskQueue, opts)
+}
+
+// RegisterServiceWithComposedConverters registers the workflows and activities of ServiceWithComposedConverters
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithComposedConverters(w worker.Registry, impl ServiceWithComposedConvertersTemporalClient) {
+	w.RegisterWorkflow(impl.Upload)
+}
+
+// StartWorkerServiceWithComposedConverters runs a worker which hosts only ServiceWithComposedConverters,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithComposedConverters(c client.Client, impl ServiceWithComposedConvertersTemporalClient, runtimeOpts ...ServiceWithComposedConvertersWorkerOption) {
+	w := NewWorkerServiceWithComposedConverters(c, runtimeOpts...)
+	RegisterServiceWithComposedConverters(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// ServiceWithComposedConvertersDataConverter returns the data converter of ServiceWithComposedConverters, which encodes
+// proto messages as JSON (as specified in its proto definition), and other
+// values like the SDK's default data converter. It can still decode proto
+// messages in both encodings.
+func ServiceWithComposedConvertersDataConverter() converter.DataConverter {
+	return converter.NewCompositeDataConverter(
+		converter.NewNilPayloadConverter(),
+		converter.NewByteSlicePayloadConverter(),
+		converter.NewProtoJSONPayloadConverter(),
+		converter.NewProtoPayloadConverter(),
+		converter.NewJSONPayloadConverter(),
+	)
+}
+
+// CheckServiceWithComposedConvertersDataConverter returns an error if the given data converter doesn't encode
+// proto messages as JSON, like ServiceWithComposedConvertersDataConverter.
+func CheckServiceWithComposedConvertersDataConverter(dc converter.DataConverter) error {
+	p, err := dc.ToPayload(&emptypb.Empty{})
+	if err != nil {
+		return fmt.Errorf("incompatible data converter for ServiceWithComposedConverters: %w", err)
+	}
+	if e := string(p.Metadata[converter.MetadataEncoding]); e != converter.MetadataEncodingProtoJSON {
+		return fmt.Errorf("incompatible data converter for ServiceWithComposedConverters: proto messages are encoded as %q instead of %q", e, converter.MetadataEncodingProtoJSON)
+	}
+	return nil
+}
+
+// ServiceWithComposedConvertersSensitiveDataConverter returns a data converter for ServiceWithComposedConverters which encrypts
+// the sensitive fields of proto messages before they leave the client or worker,
+// and decrypts them on the way in, with keys from the given key provider.
+func ServiceWithComposedConvertersSensitiveDataConverter(kp sensitive.KeyProvider) converter.DataConverter {
+	return converter.NewCompositeDataConverter(
+		converter.NewNilPayloadConverter(),
+		converter.NewByteSlicePayloadConverter(),
+		sensitive.NewPayloadConverter(converter.NewProtoJSONPayloadConverter(), kp),
+		sensitive.NewPayloadConverter(converter.NewProtoPayloadConverter(), kp),
+		converter.NewJSONPayloadConverter(),
+	)
+}
+
+// ServiceWithComposedConvertersLargePayloadThreshold is the size threshold (in bytes) of large payloads of ServiceWithComposedConverters.
+const ServiceWithComposedConvertersLargePayloadThreshold = 1048576
+
+// ServiceWithComposedConvertersLargePayloadDataConverter wraps the given data converter, so it stores payloads
+// which are larger than ServiceWithComposedConvertersLargePayloadThreshold in the given blob store, and
+// replaces them with references. Use it in both clients and workers of ServiceWithComposedConverters.
+func ServiceWithComposedConvertersLargePayloadDataConverter(dc converter.DataConverter, store claimcheck.BlobStore) converter.DataConverter {
+	return converter.NewCodecDataConverter(dc, claimcheck.NewCodec(store, ServiceWithComposedConvertersLargePayloadThreshold))
+}
+
+// DialServiceWithComposedConverters creates a client with the data converter of ServiceWithComposedConverters if the
+// given options don't specify a data converter: ServiceWithComposedConvertersSensitiveDataConverter(kp),
+// wrapped by ServiceWithComposedConvertersLargePayloadDataConverter with the given blob store.
+// It returns an error if they specify a data converter with a different
+// encoding, instead of failing later in workflows and activities.
+// Use it instead of client.Dial to create the client which is passed to the
+// other generated functions of ServiceWithComposedConverters.
+func DialServiceWithComposedConverters(opts client.Options, kp sensitive.KeyProvider, store claimcheck.BlobStore) (client.Client, error) {
+	if opts.DataConverter == nil {
+		opts.DataConverter = ServiceWithComposedConvertersLargePayloadDataConverter(ServiceWithComposedConvertersSensitiveDataConverter(kp), store)
+	} else if err := CheckServiceWithComposedConvertersDataConverter(opts.DataConverter); err != nil {
+		return nil, err
+	}
+	return client.Dial(opts)
+}
+
+// ServiceWithComposedConverters needs all the generated data converters.
+type ServiceWithComposedConvertersTemporalClient interface {
+	// Upload workflow, whose input is sensitive and may be large.
+	Upload(ctx workflow.Context, in *ComposedInput) (*ComposedOutput, error)
+}
+
+type serviceWithComposedConvertersTemporalClient struct {
+	t client.Client
+}
+
+// ServiceWithComposedConverters needs all the generated data converters.
+func NewServiceWithComposedConvertersTemporalClient(c client.Client) *ServiceWithComposedConvertersTemporalClient {
+	return newServiceWithComposedConvertersTemporalClient(c)
+}
+
+func newServiceWithComposedConvertersTemporalClient(c client.Client) *serviceWithComposedConvertersTemporalClient {
+	return &serviceWithComposedConvertersTemporalClient{c}
+}
+
+// Upload workflow, whose input is sensitive and may be large.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithComposedConvertersTemporalClient) StartWorkflowServiceWithComposedConvertersUpload(ctx context.Context, in *ComposedInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "composed-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Upload, in)
+}
+
+// Upload workflow, whose input is sensitive and may be large.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithComposedConvertersTemporalClient) ExecuteWorkflowServiceWithComposedConvertersUpload(ctx context.Context, in *ComposedInput) (*ComposedOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "composed-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Upload, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *ComposedOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Upload workflow, whose input is sensitive and may be large.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithComposedConvertersTemporalClient) StartChildWorkflowServiceWithComposedConvertersUpload(ctx workflow.Context, in *ComposedInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "composed-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Upload, in)
+}
+
+// Upload workflow, whose input is sensitive and may be large.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithComposedConvertersTemporalClient) ExecuteChildWorkflowServiceWithComposedConvertersUpload(ctx workflow.Context, in *ComposedInput) (*ComposedOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "composed-task-queue",
+	})
+	var out *ComposedOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Upload, in).Get(ctx, &out)
+	return out, err
+}
+
+// ContinueAsNewServiceWithComposedConvertersUpload returns an error which ends the current run of the Upload
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithComposedConvertersUpload(ctx workflow.Context, in *ComposedInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "composed-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Upload", in)
+}
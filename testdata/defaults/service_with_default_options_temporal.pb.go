@@ -0,0 +1,459 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_default_options.proto
+
+package defaults
+
+import (
+	context "context"
+	v1 "go.temporal.io/api/enums/v1"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	temporal "go.temporal.io/sdk/temporal"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+	time "time"
+)
+
+// ServiceWithDefaultOptionsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithDefaultOptionsWorkerOption func(*worker.Options)
+
+// WithServiceWithDefaultOptionsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithDefaultOptionsBackgroundActivityContext(ctx context.Context) ServiceWithDefaultOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithDefaultOptionsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithDefaultOptionsInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithDefaultOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithDefaultOptionsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithDefaultOptionsOnFatalError(f func(error)) ServiceWithDefaultOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithDefaultOptionsTaskQueue is the name of the task queue of the ServiceWithDefaultOptions worker.
+const ServiceWithDefaultOptionsTaskQueue = "my-task-queue"
+
+// NewWorkerServiceWithDefaultOptions creates a worker for the task queue of ServiceWithDefaultOptions,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithDefaultOptions.
+func NewWorkerServiceWithDefaultOptions(c client.Client, runtimeOpts ...ServiceWithDefaultOptionsWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithDefaultOptionsTaskQueue, opts)
+}
+
+// RegisterServiceWithDefaultOptions registers the workflows and activities of ServiceWithDefaultOptions
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithDefaultOptions(w worker.Registry, impl ServiceWithDefaultOptionsTemporalClient) {
+	w.RegisterActivity(impl.InheritedDefaults)
+	w.RegisterActivity(impl.OverriddenDefaults)
+	w.RegisterWorkflow(impl.InheritedWorkflow)
+	w.RegisterWorkflow(impl.OverriddenWorkflow)
+}
+
+// StartWorkerServiceWithDefaultOptions runs a worker which hosts only ServiceWithDefaultOptions,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithDefaultOptions(c client.Client, impl ServiceWithDefaultOptionsTemporalClient, runtimeOpts ...ServiceWithDefaultOptionsWorkerOption) {
+	w := NewWorkerServiceWithDefaultOptions(c, runtimeOpts...)
+	RegisterServiceWithDefaultOptions(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+type ServiceWithDefaultOptionsTemporalClient interface {
+	// InheritedDefaults activity: file and service defaults.
+	InheritedDefaults(ctx context.Context, in *FooInput) (*FooOutput, error)
+	// OverriddenDefaults activity: the explicit "false" overrides the file's
+	// "true", and the retry policy replaces the service's retry policy.
+	OverriddenDefaults(ctx context.Context, in *FooInput) (*FooOutput, error)
+	// InheritedWorkflow workflow.
+	InheritedWorkflow(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+	// OverriddenWorkflow workflow.
+	OverriddenWorkflow(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+}
+
+type serviceWithDefaultOptionsTemporalClient struct {
+	t client.Client
+}
+
+func NewServiceWithDefaultOptionsTemporalClient(c client.Client) *ServiceWithDefaultOptionsTemporalClient {
+	return newServiceWithDefaultOptionsTemporalClient(c)
+}
+
+func newServiceWithDefaultOptionsTemporalClient(c client.Client) *serviceWithDefaultOptionsTemporalClient {
+	return &serviceWithDefaultOptionsTemporalClient{c}
+}
+
+// InheritedDefaults activity: file and service defaults.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithDefaultOptionsTemporalClient) StartActivityServiceWithDefaultOptionsInheritedDefaults(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:              "my-task-queue",
+		ScheduleToCloseTimeout: time.Duration(60 * float64(time.Second)),
+		StartToCloseTimeout:    time.Duration(10 * float64(time.Second)),
+		WaitForCancellation:    true,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Duration(2 * float64(time.Second)),
+			BackoffCoefficient: 1.5,
+			NonRetryableErrorTypes: []string{
+				"Foo",
+				"Bar",
+			},
+		},
+	})
+	return workflow.ExecuteActivity(ctx, c.InheritedDefaults, in)
+}
+
+// InheritedDefaults activity: file and service defaults.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithDefaultOptionsTemporalClient) ExecuteActivityServiceWithDefaultOptionsInheritedDefaults(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:              "my-task-queue",
+		ScheduleToCloseTimeout: time.Duration(60 * float64(time.Second)),
+		StartToCloseTimeout:    time.Duration(10 * float64(time.Second)),
+		WaitForCancellation:    true,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Duration(2 * float64(time.Second)),
+			BackoffCoefficient: 1.5,
+			NonRetryableErrorTypes: []string{
+				"Foo",
+				"Bar",
+			},
+		},
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.InheritedDefaults, in).Get(ctx, &out)
+	return out, err
+}
+
+// InheritedDefaults activity: file and service defaults.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithDefaultOptionsTemporalClient) StartLocalActivityServiceWithDefaultOptionsInheritedDefaults(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		ScheduleToCloseTimeout: time.Duration(60 * float64(time.Second)),
+		StartToCloseTimeout:    time.Duration(10 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Duration(2 * float64(time.Second)),
+			BackoffCoefficient: 1.5,
+			NonRetryableErrorTypes: []string{
+				"Foo",
+				"Bar",
+			},
+		},
+	})
+	return workflow.ExecuteActivity(ctx, c.InheritedDefaults, in)
+}
+
+// InheritedDefaults activity: file and service defaults.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithDefaultOptionsTemporalClient) ExecuteLocalActivityServiceWithDefaultOptionsInheritedDefaults(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		ScheduleToCloseTimeout: time.Duration(60 * float64(time.Second)),
+		StartToCloseTimeout:    time.Duration(10 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Duration(2 * float64(time.Second)),
+			BackoffCoefficient: 1.5,
+			NonRetryableErrorTypes: []string{
+				"Foo",
+				"Bar",
+			},
+		},
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.InheritedDefaults, in).Get(ctx, &out)
+	return out, err
+}
+
+// OverriddenDefaults activity: the explicit "false" overrides the file's
+// "true", and the retry policy replaces the service's retry policy.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithDefaultOptionsTemporalClient) StartActivityServiceWithDefaultOptionsOverriddenDefaults(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:              "my-task-queue",
+		ScheduleToCloseTimeout: time.Duration(60 * float64(time.Second)),
+		StartToCloseTimeout:    time.Duration(5 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	})
+	return workflow.ExecuteActivity(ctx, c.OverriddenDefaults, in)
+}
+
+// OverriddenDefaults activity: the explicit "false" overrides the file's
+// "true", and the retry policy replaces the service's retry policy.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithDefaultOptionsTemporalClient) ExecuteActivityServiceWithDefaultOptionsOverriddenDefaults(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:              "my-task-queue",
+		ScheduleToCloseTimeout: time.Duration(60 * float64(time.Second)),
+		StartToCloseTimeout:    time.Duration(5 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.OverriddenDefaults, in).Get(ctx, &out)
+	return out, err
+}
+
+// OverriddenDefaults activity: the explicit "false" overrides the file's
+// "true", and the retry policy replaces the service's retry policy.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithDefaultOptionsTemporalClient) StartLocalActivityServiceWithDefaultOptionsOverriddenDefaults(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		ScheduleToCloseTimeout: time.Duration(60 * float64(time.Second)),
+		StartToCloseTimeout:    time.Duration(5 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	})
+	return workflow.ExecuteActivity(ctx, c.OverriddenDefaults, in)
+}
+
+// OverriddenDefaults activity: the explicit "false" overrides the file's
+// "true", and the retry policy replaces the service's retry policy.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithDefaultOptionsTemporalClient) ExecuteLocalActivityServiceWithDefaultOptionsOverriddenDefaults(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		ScheduleToCloseTimeout: time.Duration(60 * float64(time.Second)),
+		StartToCloseTimeout:    time.Duration(5 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.OverriddenDefaults, in).Get(ctx, &out)
+	return out, err
+}
+
+// InheritedWorkflow workflow.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithDefaultOptionsTemporalClient) StartWorkflowServiceWithDefaultOptionsInheritedWorkflow(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		WorkflowRunTimeout:       time.Duration(600 * float64(time.Second)),
+		WorkflowIDReusePolicy:    v1.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.InheritedWorkflow, in)
+}
+
+// InheritedWorkflow workflow.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithDefaultOptionsTemporalClient) ExecuteWorkflowServiceWithDefaultOptionsInheritedWorkflow(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		WorkflowRunTimeout:       time.Duration(600 * float64(time.Second)),
+		WorkflowIDReusePolicy:    v1.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.InheritedWorkflow, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// InheritedWorkflow workflow.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithDefaultOptionsTemporalClient) StartChildWorkflowServiceWithDefaultOptionsInheritedWorkflow(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		WorkflowRunTimeout:       time.Duration(600 * float64(time.Second)),
+		WorkflowIDReusePolicy:    v1.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.InheritedWorkflow, in)
+}
+
+// InheritedWorkflow workflow.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithDefaultOptionsTemporalClient) ExecuteChildWorkflowServiceWithDefaultOptionsInheritedWorkflow(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		WorkflowRunTimeout:       time.Duration(600 * float64(time.Second)),
+		WorkflowIDReusePolicy:    v1.WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.InheritedWorkflow, in).Get(ctx, &out)
+	return out, err
+}
+
+// OverriddenWorkflow workflow.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithDefaultOptionsTemporalClient) StartWorkflowServiceWithDefaultOptionsOverriddenWorkflow(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		WorkflowRunTimeout:       time.Duration(60 * float64(time.Second)),
+		CronSchedule:             "@daily",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.OverriddenWorkflow, in)
+}
+
+// OverriddenWorkflow workflow.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithDefaultOptionsTemporalClient) ExecuteWorkflowServiceWithDefaultOptionsOverriddenWorkflow(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		WorkflowRunTimeout:       time.Duration(60 * float64(time.Second)),
+		CronSchedule:             "@daily",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.OverriddenWorkflow, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// OverriddenWorkflow workflow.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithDefaultOptionsTemporalClient) StartChildWorkflowServiceWithDefaultOptionsOverriddenWorkflow(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		WorkflowRunTimeout:       time.Duration(60 * float64(time.Second)),
+		CronSchedule:             "@daily",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.OverriddenWorkflow, in)
+}
+
+// OverriddenWorkflow workflow.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithDefaultOptionsTemporalClient) ExecuteChildWorkflowServiceWithDefaultOptionsOverriddenWorkflow(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		WorkflowRunTimeout:       time.Duration(60 * float64(time.Second)),
+		CronSchedule:             "@daily",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.OverriddenWorkflow, in).Get(ctx, &out)
+	return out, err
+}
+
+// ContinueAsNewServiceWithDefaultOptionsInheritedWorkflow returns an error which ends the current run of the InheritedWorkflow
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithDefaultOptionsInheritedWorkflow(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	ctx = workflow.WithWorkflowRunTimeout(ctx, time.Duration(600*float64(time.Second)))
+	return workflow.NewContinueAsNewError(ctx, "InheritedWorkflow", in)
+}
+
+// ContinueAsNewServiceWithDefaultOptionsOverriddenWorkflow returns an error which ends the current run of the OverriddenWorkflow
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithDefaultOptionsOverriddenWorkflow(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	ctx = workflow.WithWorkflowRunTimeout(ctx, time.Duration(60*float64(time.Second)))
+	return workflow.NewContinueAsNewError(ctx, "OverriddenWorkflow", in)
+}
@@ -0,0 +1,234 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: billing.proto
+
+package diagrams
+
+import (
+	context "context"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+	time "time"
+)
+
+// BillingWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type BillingWorkerOption func(*worker.Options)
+
+// WithBillingBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithBillingBackgroundActivityContext(ctx context.Context) BillingWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithBillingInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithBillingInterceptors(interceptors ...interceptor.WorkerInterceptor) BillingWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithBillingOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithBillingOnFatalError(f func(error)) BillingWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// BillingTaskQueue is the name of the task queue of the Billing worker.
+const BillingTaskQueue = "billing"
+
+// NewWorkerBilling creates a worker for the task queue of Billing,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterBilling.
+func NewWorkerBilling(c client.Client, runtimeOpts ...BillingWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, BillingTaskQueue, opts)
+}
+
+// RegisterBilling registers the workflows and activities of Billing
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterBilling(w worker.Registry, impl BillingTemporalClient) {
+	w.RegisterActivity(impl.Charge)
+	w.RegisterActivity(impl.Refund)
+}
+
+// StartWorkerBilling runs a worker which hosts only Billing,
+// until the process receives an interrupt signal.
+func StartWorkerBilling(c client.Client, impl BillingTemporalClient, runtimeOpts ...BillingWorkerOption) {
+	w := NewWorkerBilling(c, runtimeOpts...)
+	RegisterBilling(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// Billing service, whose activities are called by workflows in other files.
+type BillingTemporalClient interface {
+	// Charge activity, scheduled in the "billing" task queue.
+	Charge(ctx context.Context, in *FooInput) (*FooOutput, error)
+	// Refund activity, routed to another task queue.
+	Refund(ctx context.Context, in *FooInput) (*FooOutput, error)
+}
+
+type billingTemporalClient struct {
+	t client.Client
+}
+
+// Billing service, whose activities are called by workflows in other files.
+func NewBillingTemporalClient(c client.Client) *BillingTemporalClient {
+	return newBillingTemporalClient(c)
+}
+
+func newBillingTemporalClient(c client.Client) *billingTemporalClient {
+	return &billingTemporalClient{c}
+}
+
+// Charge activity, scheduled in the "billing" task queue.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *billingTemporalClient) StartActivityBillingCharge(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "billing",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Charge, in)
+}
+
+// Charge activity, scheduled in the "billing" task queue.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *billingTemporalClient) ExecuteActivityBillingCharge(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "billing",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Charge, in).Get(ctx, &out)
+	return out, err
+}
+
+// Charge activity, scheduled in the "billing" task queue.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *billingTemporalClient) StartLocalActivityBillingCharge(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Charge, in)
+}
+
+// Charge activity, scheduled in the "billing" task queue.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *billingTemporalClient) ExecuteLocalActivityBillingCharge(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Charge, in).Get(ctx, &out)
+	return out, err
+}
+
+// Refund activity, routed to another task queue.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *billingTemporalClient) StartActivityBillingRefund(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "refunds",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Refund, in)
+}
+
+// Refund activity, routed to another task queue.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *billingTemporalClient) ExecuteActivityBillingRefund(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "refunds",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Refund, in).Get(ctx, &out)
+	return out, err
+}
+
+// Refund activity, routed to another task queue.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *billingTemporalClient) StartLocalActivityBillingRefund(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Refund, in)
+}
+
+// Refund activity, routed to another task queue.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *billingTemporalClient) ExecuteLocalActivityBillingRefund(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Refund, in).Get(ctx, &out)
+	return out, err
+}
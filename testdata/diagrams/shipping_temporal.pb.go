@@ -0,0 +1,245 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: shipping.proto
+
+package diagrams
+
+import (
+	context "context"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+	time "time"
+)
+
+// ShippingWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ShippingWorkerOption func(*worker.Options)
+
+// WithShippingBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithShippingBackgroundActivityContext(ctx context.Context) ShippingWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithShippingInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithShippingInterceptors(interceptors ...interceptor.WorkerInterceptor) ShippingWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithShippingOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithShippingOnFatalError(f func(error)) ShippingWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ShippingTaskQueue is the name of the task queue of the Shipping worker.
+const ShippingTaskQueue = "shipping"
+
+// NewWorkerShipping creates a worker for the task queue of Shipping,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterShipping.
+func NewWorkerShipping(c client.Client, runtimeOpts ...ShippingWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ShippingTaskQueue, opts)
+}
+
+// RegisterShipping registers the workflows and activities of Shipping
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterShipping(w worker.Registry, impl ShippingTemporalClient) {
+	w.RegisterWorkflow(impl.Deliver)
+	w.RegisterActivity(impl.Notify)
+}
+
+// StartWorkerShipping runs a worker which hosts only Shipping,
+// until the process receives an interrupt signal.
+func StartWorkerShipping(c client.Client, impl ShippingTemporalClient, runtimeOpts ...ShippingWorkerOption) {
+	w := NewWorkerShipping(c, runtimeOpts...)
+	RegisterShipping(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// Shipping service, drawn as a Graphviz graph.
+type ShippingTemporalClient interface {
+	// Deliver workflow, which calls activities by their fully-qualified names.
+	Deliver(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+	// Notify activity.
+	Notify(ctx context.Context, in *FooInput) (*FooOutput, error)
+}
+
+type shippingTemporalClient struct {
+	t client.Client
+}
+
+// Shipping service, drawn as a Graphviz graph.
+func NewShippingTemporalClient(c client.Client) *ShippingTemporalClient {
+	return newShippingTemporalClient(c)
+}
+
+func newShippingTemporalClient(c client.Client) *shippingTemporalClient {
+	return &shippingTemporalClient{c}
+}
+
+// Deliver workflow, which calls activities by their fully-qualified names.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *shippingTemporalClient) StartWorkflowShippingDeliver(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "shipping",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Deliver, in)
+}
+
+// Deliver workflow, which calls activities by their fully-qualified names.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *shippingTemporalClient) ExecuteWorkflowShippingDeliver(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "shipping",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Deliver, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Deliver workflow, which calls activities by their fully-qualified names.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *shippingTemporalClient) StartChildWorkflowShippingDeliver(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "shipping",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Deliver, in)
+}
+
+// Deliver workflow, which calls activities by their fully-qualified names.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *shippingTemporalClient) ExecuteChildWorkflowShippingDeliver(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "shipping",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Deliver, in).Get(ctx, &out)
+	return out, err
+}
+
+// Notify activity.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *shippingTemporalClient) StartActivityShippingNotify(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "shipping",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Notify, in)
+}
+
+// Notify activity.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *shippingTemporalClient) ExecuteActivityShippingNotify(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "shipping",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Notify, in).Get(ctx, &out)
+	return out, err
+}
+
+// Notify activity.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *shippingTemporalClient) StartLocalActivityShippingNotify(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Notify, in)
+}
+
+// Notify activity.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *shippingTemporalClient) ExecuteLocalActivityShippingNotify(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Notify, in).Get(ctx, &out)
+	return out, err
+}
+
+// ContinueAsNewShippingDeliver returns an error which ends the current run of the Deliver
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewShippingDeliver(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "shipping")
+	return workflow.NewContinueAsNewError(ctx, "Deliver", in)
+}
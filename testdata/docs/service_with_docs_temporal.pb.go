@@ -0,0 +1,375 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_docs.proto
+
+package docs
+
+import (
+	context "context"
+	activity "go.temporal.io/sdk/activity"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	temporal "go.temporal.io/sdk/temporal"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+	time "time"
+)
+
+// ServiceWithDocsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithDocsWorkerOption func(*worker.Options)
+
+// WithServiceWithDocsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithDocsBackgroundActivityContext(ctx context.Context) ServiceWithDocsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithDocsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithDocsInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithDocsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithDocsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithDocsOnFatalError(f func(error)) ServiceWithDocsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithDocsTaskQueue is the name of the task queue of the ServiceWithDocs worker.
+const ServiceWithDocsTaskQueue = "my-task-queue"
+
+// NewWorkerServiceWithDocs creates a worker for the task queue of ServiceWithDocs,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithDocs.
+func NewWorkerServiceWithDocs(c client.Client, runtimeOpts ...ServiceWithDocsWorkerOption) worker.Worker {
+	opts := worker.Options{
+		MaxConcurrentActivityExecutionSize: 10,
+		WorkerActivitiesPerSecond:          2.5,
+	}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithDocsTaskQueue, opts)
+}
+
+// RegisterServiceWithDocs registers the workflows and activities of ServiceWithDocs
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithDocs(w worker.Registry, impl ServiceWithDocsTemporalClient) {
+	w.RegisterWorkflow(impl.Foo)
+	w.RegisterActivity(impl.Bar)
+	w.RegisterActivity(impl.Baz)
+}
+
+// StartWorkerServiceWithDocs runs a worker which hosts only ServiceWithDocs,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithDocs(c client.Client, impl ServiceWithDocsTemporalClient, runtimeOpts ...ServiceWithDocsWorkerOption) {
+	w := NewWorkerServiceWithDocs(c, runtimeOpts...)
+	RegisterServiceWithDocs(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// ServiceWithDocs is documented in Markdown,
+// in addition to the generated Go code.
+type ServiceWithDocsTemporalClient interface {
+	// Foo workflow, with non-default options.
+	Foo(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+	// Bar activity, with heartbeats and a task queue of its own.
+	//
+	// This activity heartbeats with *Progress details, using
+	// RecordHeartbeatServiceWithDocsBar and GetHeartbeatServiceWithDocsBar.
+	Bar(ctx context.Context, in *FooInput) error
+	// Baz activity, which is deprecated.
+	//
+	// Deprecated: Do not use.
+	Baz(ctx context.Context, in *FooInput) (*FooOutput, error)
+}
+
+type serviceWithDocsTemporalClient struct {
+	t client.Client
+}
+
+// ServiceWithDocs is documented in Markdown,
+// in addition to the generated Go code.
+func NewServiceWithDocsTemporalClient(c client.Client) *ServiceWithDocsTemporalClient {
+	return newServiceWithDocsTemporalClient(c)
+}
+
+func newServiceWithDocsTemporalClient(c client.Client) *serviceWithDocsTemporalClient {
+	return &serviceWithDocsTemporalClient{c}
+}
+
+// Foo workflow, with non-default options.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithDocsTemporalClient) StartWorkflowServiceWithDocsFoo(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+			NonRetryableErrorTypes: []string{
+				"Fatal",
+				"Invalid",
+			},
+		},
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+}
+
+// Foo workflow, with non-default options.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithDocsTemporalClient) ExecuteWorkflowServiceWithDocsFoo(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+			NonRetryableErrorTypes: []string{
+				"Fatal",
+				"Invalid",
+			},
+		},
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Foo workflow, with non-default options.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithDocsTemporalClient) StartChildWorkflowServiceWithDocsFoo(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+			NonRetryableErrorTypes: []string{
+				"Fatal",
+				"Invalid",
+			},
+		},
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Foo, in)
+}
+
+// Foo workflow, with non-default options.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithDocsTemporalClient) ExecuteChildWorkflowServiceWithDocsFoo(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+			NonRetryableErrorTypes: []string{
+				"Fatal",
+				"Invalid",
+			},
+		},
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Foo, in).Get(ctx, &out)
+	return out, err
+}
+
+// Bar activity, with heartbeats and a task queue of its own.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithDocsTemporalClient) StartActivityServiceWithDocsBar(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "bar-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		HeartbeatTimeout:    time.Duration(30 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity, with heartbeats and a task queue of its own.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithDocsTemporalClient) ExecuteActivityServiceWithDocsBar(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "bar-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		HeartbeatTimeout:    time.Duration(30 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in).Get(ctx, nil)
+}
+
+// Bar activity, with heartbeats and a task queue of its own.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithDocsTemporalClient) StartLocalActivityServiceWithDocsBar(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity, with heartbeats and a task queue of its own.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithDocsTemporalClient) ExecuteLocalActivityServiceWithDocsBar(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteLocalActivity(ctx, c.Bar, in).Get(ctx, nil)
+}
+
+// Baz activity, which is deprecated.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+//
+// Deprecated: Do not use.
+func (c *serviceWithDocsTemporalClient) StartActivityServiceWithDocsBaz(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Baz, in)
+}
+
+// Baz activity, which is deprecated.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+//
+// Deprecated: Do not use.
+func (c *serviceWithDocsTemporalClient) ExecuteActivityServiceWithDocsBaz(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Baz, in).Get(ctx, &out)
+	return out, err
+}
+
+// Baz activity, which is deprecated.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+//
+// Deprecated: Do not use.
+func (c *serviceWithDocsTemporalClient) StartLocalActivityServiceWithDocsBaz(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Baz, in)
+}
+
+// Baz activity, which is deprecated.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+//
+// Deprecated: Do not use.
+func (c *serviceWithDocsTemporalClient) ExecuteLocalActivityServiceWithDocsBaz(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Baz, in).Get(ctx, &out)
+	return out, err
+}
+
+// RecordHeartbeatServiceWithDocsBar reports the progress of the Bar activity to Temporal.
+// The details are available to the next attempt of the activity if the current
+// one fails or times out - see GetHeartbeatServiceWithDocsBar. For more information, see
+// https://docs.temporal.io/dev-guide/go/features#activity-heartbeats.
+func RecordHeartbeatServiceWithDocsBar(ctx context.Context, details *Progress) {
+	activity.RecordHeartbeat(ctx, details)
+}
+
+// GetHeartbeatServiceWithDocsBar returns the details of the last heartbeat which a previous
+// attempt of the Bar activity recorded, and whether there is one.
+func GetHeartbeatServiceWithDocsBar(ctx context.Context) (*Progress, bool, error) {
+	if !activity.HasHeartbeatDetails(ctx) {
+		return nil, false, nil
+	}
+	var details *Progress
+	if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+		return nil, false, err
+	}
+	return details, true, nil
+}
+
+// ContinueAsNewServiceWithDocsFoo returns an error which ends the current run of the Foo
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithDocsFoo(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Foo", in)
+}
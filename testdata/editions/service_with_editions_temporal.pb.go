@@ -0,0 +1,236 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_editions.proto
+
+package editions
+
+import (
+	context "context"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+)
+
+// ServiceWithEditionsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithEditionsWorkerOption func(*worker.Options)
+
+// WithServiceWithEditionsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithEditionsBackgroundActivityContext(ctx context.Context) ServiceWithEditionsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithEditionsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithEditionsInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithEditionsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithEditionsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithEditionsOnFatalError(f func(error)) ServiceWithEditionsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithEditionsTaskQueue is the name of the task queue of the ServiceWithEditions worker.
+const ServiceWithEditionsTaskQueue = "my-task-queue"
+
+// NewWorkerServiceWithEditions creates a worker for the task queue of ServiceWithEditions,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithEditions.
+func NewWorkerServiceWithEditions(c client.Client, runtimeOpts ...ServiceWithEditionsWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithEditionsTaskQueue, opts)
+}
+
+// RegisterServiceWithEditions registers the workflows and activities of ServiceWithEditions
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithEditions(w worker.Registry, impl ServiceWithEditionsTemporalClient) {
+	w.RegisterWorkflow(impl.Foo)
+	w.RegisterActivity(impl.Bar)
+}
+
+// StartWorkerServiceWithEditions runs a worker which hosts only ServiceWithEditions,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithEditions(c client.Client, impl ServiceWithEditionsTemporalClient, runtimeOpts ...ServiceWithEditionsWorkerOption) {
+	w := NewWorkerServiceWithEditions(c, runtimeOpts...)
+	RegisterServiceWithEditions(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+type ServiceWithEditionsTemporalClient interface {
+	// Foo workflow.
+	Foo(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+	// Bar activity.
+	Bar(ctx context.Context, in *FooInput) (*FooOutput, error)
+}
+
+type serviceWithEditionsTemporalClient struct {
+	t client.Client
+}
+
+func NewServiceWithEditionsTemporalClient(c client.Client) *ServiceWithEditionsTemporalClient {
+	return newServiceWithEditionsTemporalClient(c)
+}
+
+func newServiceWithEditionsTemporalClient(c client.Client) *serviceWithEditionsTemporalClient {
+	return &serviceWithEditionsTemporalClient{c}
+}
+
+// Foo workflow.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithEditionsTemporalClient) StartWorkflowServiceWithEditionsFoo(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+}
+
+// Foo workflow.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithEditionsTemporalClient) ExecuteWorkflowServiceWithEditionsFoo(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Foo workflow.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithEditionsTemporalClient) StartChildWorkflowServiceWithEditionsFoo(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Foo, in)
+}
+
+// Foo workflow.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithEditionsTemporalClient) ExecuteChildWorkflowServiceWithEditionsFoo(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Foo, in).Get(ctx, &out)
+	return out, err
+}
+
+// Bar activity.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithEditionsTemporalClient) StartActivityServiceWithEditionsBar(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithEditionsTemporalClient) ExecuteActivityServiceWithEditionsBar(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue: "my-task-queue",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Bar, in).Get(ctx, &out)
+	return out, err
+}
+
+// Bar activity.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithEditionsTemporalClient) StartLocalActivityServiceWithEditionsBar(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithEditionsTemporalClient) ExecuteLocalActivityServiceWithEditionsBar(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Bar, in).Get(ctx, &out)
+	return out, err
+}
+
+// ContinueAsNewServiceWithEditionsFoo returns an error which ends the current run of the Foo
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithEditionsFoo(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Foo", in)
+}
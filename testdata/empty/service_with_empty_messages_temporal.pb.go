@@ -0,0 +1,298 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_empty_messages.proto
+
+package empty
+
+import (
+	context "context"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+)
+
+// ServiceWithEmptyMessagesWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithEmptyMessagesWorkerOption func(*worker.Options)
+
+// WithServiceWithEmptyMessagesBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithEmptyMessagesBackgroundActivityContext(ctx context.Context) ServiceWithEmptyMessagesWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithEmptyMessagesInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithEmptyMessagesInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithEmptyMessagesWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithEmptyMessagesOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithEmptyMessagesOnFatalError(f func(error)) ServiceWithEmptyMessagesWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithEmptyMessagesTaskQueue is the name of the task queue of the ServiceWithEmptyMessages worker.
+const ServiceWithEmptyMessagesTaskQueue = "my-task-queue"
+
+// NewWorkerServiceWithEmptyMessages creates a worker for the task queue of ServiceWithEmptyMessages,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithEmptyMessages.
+func NewWorkerServiceWithEmptyMessages(c client.Client, runtimeOpts ...ServiceWithEmptyMessagesWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithEmptyMessagesTaskQueue, opts)
+}
+
+// RegisterServiceWithEmptyMessages registers the workflows and activities of ServiceWithEmptyMessages
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithEmptyMessages(w worker.Registry, impl ServiceWithEmptyMessagesTemporalClient) {
+	w.RegisterWorkflow(impl.EmptyInput)
+	w.RegisterWorkflow(impl.EmptyOutput)
+	w.RegisterActivity(impl.EmptyInputAndOutput)
+}
+
+// StartWorkerServiceWithEmptyMessages runs a worker which hosts only ServiceWithEmptyMessages,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithEmptyMessages(c client.Client, impl ServiceWithEmptyMessagesTemporalClient, runtimeOpts ...ServiceWithEmptyMessagesWorkerOption) {
+	w := NewWorkerServiceWithEmptyMessages(c, runtimeOpts...)
+	RegisterServiceWithEmptyMessages(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+type ServiceWithEmptyMessagesTemporalClient interface {
+	// EmptyInput workflow.
+	EmptyInput(ctx workflow.Context) (*FooOutput, error)
+	// EmptyOutput workflow.
+	EmptyOutput(ctx workflow.Context, in *FooInput) error
+	// EmptyInputAndOutput activity.
+	EmptyInputAndOutput(ctx context.Context) error
+}
+
+type serviceWithEmptyMessagesTemporalClient struct {
+	t client.Client
+}
+
+func NewServiceWithEmptyMessagesTemporalClient(c client.Client) *ServiceWithEmptyMessagesTemporalClient {
+	return newServiceWithEmptyMessagesTemporalClient(c)
+}
+
+func newServiceWithEmptyMessagesTemporalClient(c client.Client) *serviceWithEmptyMessagesTemporalClient {
+	return &serviceWithEmptyMessagesTemporalClient{c}
+}
+
+// EmptyInput workflow.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithEmptyMessagesTemporalClient) StartWorkflowServiceWithEmptyMessagesEmptyInput(ctx context.Context) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.EmptyInput)
+}
+
+// EmptyInput workflow.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithEmptyMessagesTemporalClient) ExecuteWorkflowServiceWithEmptyMessagesEmptyInput(ctx context.Context) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.EmptyInput)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// EmptyInput workflow.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithEmptyMessagesTemporalClient) StartChildWorkflowServiceWithEmptyMessagesEmptyInput(ctx workflow.Context) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.EmptyInput)
+}
+
+// EmptyInput workflow.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithEmptyMessagesTemporalClient) ExecuteChildWorkflowServiceWithEmptyMessagesEmptyInput(ctx workflow.Context) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.EmptyInput).Get(ctx, &out)
+	return out, err
+}
+
+// EmptyOutput workflow.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithEmptyMessagesTemporalClient) StartWorkflowServiceWithEmptyMessagesEmptyOutput(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.EmptyOutput, in)
+}
+
+// EmptyOutput workflow.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithEmptyMessagesTemporalClient) ExecuteWorkflowServiceWithEmptyMessagesEmptyOutput(ctx context.Context, in *FooInput) error {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.EmptyOutput, in)
+	if err != nil {
+		return err
+	}
+	return run.Get(ctx, nil)
+}
+
+// EmptyOutput workflow.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithEmptyMessagesTemporalClient) StartChildWorkflowServiceWithEmptyMessagesEmptyOutput(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.EmptyOutput, in)
+}
+
+// EmptyOutput workflow.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithEmptyMessagesTemporalClient) ExecuteChildWorkflowServiceWithEmptyMessagesEmptyOutput(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.EmptyOutput, in).Get(ctx, nil)
+}
+
+// EmptyInputAndOutput activity.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithEmptyMessagesTemporalClient) StartActivityServiceWithEmptyMessagesEmptyInputAndOutput(ctx workflow.Context) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteActivity(ctx, c.EmptyInputAndOutput)
+}
+
+// EmptyInputAndOutput activity.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithEmptyMessagesTemporalClient) ExecuteActivityServiceWithEmptyMessagesEmptyInputAndOutput(ctx workflow.Context) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteActivity(ctx, c.EmptyInputAndOutput).Get(ctx, nil)
+}
+
+// EmptyInputAndOutput activity.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithEmptyMessagesTemporalClient) StartLocalActivityServiceWithEmptyMessagesEmptyInputAndOutput(ctx workflow.Context) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{})
+	return workflow.ExecuteActivity(ctx, c.EmptyInputAndOutput)
+}
+
+// EmptyInputAndOutput activity.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithEmptyMessagesTemporalClient) ExecuteLocalActivityServiceWithEmptyMessagesEmptyInputAndOutput(ctx workflow.Context) error {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{})
+	return workflow.ExecuteLocalActivity(ctx, c.EmptyInputAndOutput).Get(ctx, nil)
+}
+
+// ContinueAsNewServiceWithEmptyMessagesEmptyInput returns an error which ends the current run of the EmptyInput
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithEmptyMessagesEmptyInput(ctx workflow.Context) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "EmptyInput")
+}
+
+// ContinueAsNewServiceWithEmptyMessagesEmptyOutput returns an error which ends the current run of the EmptyOutput
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithEmptyMessagesEmptyOutput(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "EmptyOutput", in)
+}
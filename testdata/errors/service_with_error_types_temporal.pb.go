@@ -0,0 +1,490 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_error_types.proto
+
+package errors
+
+import (
+	context "context"
+	errors "errors"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	temporal "go.temporal.io/sdk/temporal"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+	time "time"
+)
+
+// ServiceWithErrorTypesRetryPolicyPatient is the retry policy preset "patient" of service ServiceWithErrorTypes.
+var ServiceWithErrorTypesRetryPolicyPatient = &temporal.RetryPolicy{
+	MaximumAttempts: 100,
+	NonRetryableErrorTypes: []string{
+		"Fatal",
+	},
+}
+
+// ServiceWithErrorTypesWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithErrorTypesWorkerOption func(*worker.Options)
+
+// WithServiceWithErrorTypesBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithErrorTypesBackgroundActivityContext(ctx context.Context) ServiceWithErrorTypesWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithErrorTypesInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithErrorTypesInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithErrorTypesWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithErrorTypesOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithErrorTypesOnFatalError(f func(error)) ServiceWithErrorTypesWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithErrorTypesTaskQueue is the name of the task queue of the ServiceWithErrorTypes worker.
+const ServiceWithErrorTypesTaskQueue = "my-task-queue"
+
+// NewWorkerServiceWithErrorTypes creates a worker for the task queue of ServiceWithErrorTypes,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithErrorTypes.
+func NewWorkerServiceWithErrorTypes(c client.Client, runtimeOpts ...ServiceWithErrorTypesWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithErrorTypesTaskQueue, opts)
+}
+
+// RegisterServiceWithErrorTypes registers the workflows and activities of ServiceWithErrorTypes
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithErrorTypes(w worker.Registry, impl ServiceWithErrorTypesTemporalClient) {
+	w.RegisterActivity(impl.Charge)
+	w.RegisterActivity(impl.Refund)
+	w.RegisterWorkflow(impl.Checkout)
+	w.RegisterWorkflow(impl.Settle)
+}
+
+// StartWorkerServiceWithErrorTypes runs a worker which hosts only ServiceWithErrorTypes,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithErrorTypes(c client.Client, impl ServiceWithErrorTypesTemporalClient, runtimeOpts ...ServiceWithErrorTypesWorkerOption) {
+	w := NewWorkerServiceWithErrorTypes(c, runtimeOpts...)
+	RegisterServiceWithErrorTypes(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+type ServiceWithErrorTypesTemporalClient interface {
+	// Charge activity, with a retryable and a non-retryable error type.
+	Charge(ctx context.Context, in *FooInput) (*FooOutput, error)
+	// Refund activity, which shares an error type with Charge.
+	Refund(ctx context.Context, in *FooInput) (*FooOutput, error)
+	// Checkout workflow, with a non-retryable error type, but without a retry
+	// policy, so it isn't retried at all.
+	Checkout(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+	// Settle workflow, with a non-retryable error type and a retry policy.
+	Settle(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+}
+
+type serviceWithErrorTypesTemporalClient struct {
+	t client.Client
+}
+
+func NewServiceWithErrorTypesTemporalClient(c client.Client) *ServiceWithErrorTypesTemporalClient {
+	return newServiceWithErrorTypesTemporalClient(c)
+}
+
+func newServiceWithErrorTypesTemporalClient(c client.Client) *serviceWithErrorTypesTemporalClient {
+	return &serviceWithErrorTypesTemporalClient{c}
+}
+
+// Charge activity, with a retryable and a non-retryable error type.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithErrorTypesTemporalClient) StartActivityServiceWithErrorTypesCharge(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 100,
+			NonRetryableErrorTypes: []string{
+				"Fatal",
+				"InsufficientFunds",
+			},
+		},
+	})
+	return workflow.ExecuteActivity(ctx, c.Charge, in)
+}
+
+// Charge activity, with a retryable and a non-retryable error type.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithErrorTypesTemporalClient) ExecuteActivityServiceWithErrorTypesCharge(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 100,
+			NonRetryableErrorTypes: []string{
+				"Fatal",
+				"InsufficientFunds",
+			},
+		},
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Charge, in).Get(ctx, &out)
+	return out, err
+}
+
+// Charge activity, with a retryable and a non-retryable error type.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithErrorTypesTemporalClient) StartLocalActivityServiceWithErrorTypesCharge(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 100,
+			NonRetryableErrorTypes: []string{
+				"Fatal",
+				"InsufficientFunds",
+			},
+		},
+	})
+	return workflow.ExecuteActivity(ctx, c.Charge, in)
+}
+
+// Charge activity, with a retryable and a non-retryable error type.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithErrorTypesTemporalClient) ExecuteLocalActivityServiceWithErrorTypesCharge(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 100,
+			NonRetryableErrorTypes: []string{
+				"Fatal",
+				"InsufficientFunds",
+			},
+		},
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Charge, in).Get(ctx, &out)
+	return out, err
+}
+
+// Refund activity, which shares an error type with Charge.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithErrorTypesTemporalClient) StartActivityServiceWithErrorTypesRefund(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Refund, in)
+}
+
+// Refund activity, which shares an error type with Charge.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithErrorTypesTemporalClient) ExecuteActivityServiceWithErrorTypesRefund(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Refund, in).Get(ctx, &out)
+	return out, err
+}
+
+// Refund activity, which shares an error type with Charge.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithErrorTypesTemporalClient) StartLocalActivityServiceWithErrorTypesRefund(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Refund, in)
+}
+
+// Refund activity, which shares an error type with Charge.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithErrorTypesTemporalClient) ExecuteLocalActivityServiceWithErrorTypesRefund(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Refund, in).Get(ctx, &out)
+	return out, err
+}
+
+// Checkout workflow, with a non-retryable error type, but without a retry
+// policy, so it isn't retried at all.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithErrorTypesTemporalClient) StartWorkflowServiceWithErrorTypesCheckout(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Checkout, in)
+}
+
+// Checkout workflow, with a non-retryable error type, but without a retry
+// policy, so it isn't retried at all.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithErrorTypesTemporalClient) ExecuteWorkflowServiceWithErrorTypesCheckout(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Checkout, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Checkout workflow, with a non-retryable error type, but without a retry
+// policy, so it isn't retried at all.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithErrorTypesTemporalClient) StartChildWorkflowServiceWithErrorTypesCheckout(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Checkout, in)
+}
+
+// Checkout workflow, with a non-retryable error type, but without a retry
+// policy, so it isn't retried at all.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithErrorTypesTemporalClient) ExecuteChildWorkflowServiceWithErrorTypesCheckout(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Checkout, in).Get(ctx, &out)
+	return out, err
+}
+
+// Settle workflow, with a non-retryable error type and a retry policy.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithErrorTypesTemporalClient) StartWorkflowServiceWithErrorTypesSettle(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+			NonRetryableErrorTypes: []string{
+				"InsufficientFunds",
+			},
+		},
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Settle, in)
+}
+
+// Settle workflow, with a non-retryable error type and a retry policy.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithErrorTypesTemporalClient) ExecuteWorkflowServiceWithErrorTypesSettle(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+			NonRetryableErrorTypes: []string{
+				"InsufficientFunds",
+			},
+		},
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Settle, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Settle workflow, with a non-retryable error type and a retry policy.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithErrorTypesTemporalClient) StartChildWorkflowServiceWithErrorTypesSettle(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+			NonRetryableErrorTypes: []string{
+				"InsufficientFunds",
+			},
+		},
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Settle, in)
+}
+
+// Settle workflow, with a non-retryable error type and a retry policy.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithErrorTypesTemporalClient) ExecuteChildWorkflowServiceWithErrorTypesSettle(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+			NonRetryableErrorTypes: []string{
+				"InsufficientFunds",
+			},
+		},
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Settle, in).Get(ctx, &out)
+	return out, err
+}
+
+// ServiceWithErrorTypesInsufficientFundsErrorType is the type of ServiceWithErrorTypes application errors with
+// *InsufficientFunds details.
+const ServiceWithErrorTypesInsufficientFundsErrorType = "InsufficientFunds"
+
+// NewServiceWithErrorTypesInsufficientFundsError returns an application error with the given details,
+// to return from workflows and activities. Its type is ServiceWithErrorTypesInsufficientFundsErrorType.
+// Methods which declare this error type don't retry it.
+func NewServiceWithErrorTypesInsufficientFundsError(message string, cause error, details *InsufficientFunds) error {
+	return temporal.NewApplicationErrorWithCause(message, ServiceWithErrorTypesInsufficientFundsErrorType, cause, details)
+}
+
+// AsServiceWithErrorTypesInsufficientFunds reports whether the given error (or any error in its
+// chain) is an application error whose type is ServiceWithErrorTypesInsufficientFundsErrorType,
+// and if so returns its details.
+func AsServiceWithErrorTypesInsufficientFunds(err error) (*InsufficientFunds, bool) {
+	var appErr *temporal.ApplicationError
+	if !errors.As(err, &appErr) || appErr.Type() != ServiceWithErrorTypesInsufficientFundsErrorType || !appErr.HasDetails() {
+		return nil, false
+	}
+	var details *InsufficientFunds
+	if err := appErr.Details(&details); err != nil {
+		return nil, false
+	}
+	return details, true
+}
+
+// ServiceWithErrorTypesRateLimitedErrorType is the type of ServiceWithErrorTypes application errors with
+// *RateLimited details.
+const ServiceWithErrorTypesRateLimitedErrorType = "RateLimited"
+
+// NewServiceWithErrorTypesRateLimitedError returns an application error with the given details,
+// to return from workflows and activities. Its type is ServiceWithErrorTypesRateLimitedErrorType.
+func NewServiceWithErrorTypesRateLimitedError(message string, cause error, details *RateLimited) error {
+	return temporal.NewApplicationErrorWithCause(message, ServiceWithErrorTypesRateLimitedErrorType, cause, details)
+}
+
+// AsServiceWithErrorTypesRateLimited reports whether the given error (or any error in its
+// chain) is an application error whose type is ServiceWithErrorTypesRateLimitedErrorType,
+// and if so returns its details.
+func AsServiceWithErrorTypesRateLimited(err error) (*RateLimited, bool) {
+	var appErr *temporal.ApplicationError
+	if !errors.As(err, &appErr) || appErr.Type() != ServiceWithErrorTypesRateLimitedErrorType || !appErr.HasDetails() {
+		return nil, false
+	}
+	var details *RateLimited
+	if err := appErr.Details(&details); err != nil {
+		return nil, false
+	}
+	return details, true
+}
+
+// ContinueAsNewServiceWithErrorTypesCheckout returns an error which ends the current run of the Checkout
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithErrorTypesCheckout(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Checkout", in)
+}
+
+// ContinueAsNewServiceWithErrorTypesSettle returns an error which ends the current run of the Settle
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithErrorTypesSettle(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Settle", in)
+}
@@ -0,0 +1,506 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_grpc_server.proto
+
+package grpcserver
+
+import (
+	context "context"
+	errors "errors"
+	serviceerror "go.temporal.io/api/serviceerror"
+	activity "go.temporal.io/sdk/activity"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	temporal "go.temporal.io/sdk/temporal"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	metadata "google.golang.org/grpc/metadata"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	log "log"
+	time "time"
+)
+
+// ServiceWithGrpcServerWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithGrpcServerWorkerOption func(*worker.Options)
+
+// WithServiceWithGrpcServerBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithGrpcServerBackgroundActivityContext(ctx context.Context) ServiceWithGrpcServerWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithGrpcServerInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithGrpcServerInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithGrpcServerWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithGrpcServerOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithGrpcServerOnFatalError(f func(error)) ServiceWithGrpcServerWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithGrpcServerTaskQueue is the name of the task queue of the ServiceWithGrpcServer worker.
+const ServiceWithGrpcServerTaskQueue = "my-task-queue"
+
+// NewWorkerServiceWithGrpcServer creates a worker for the task queue of ServiceWithGrpcServer,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithGrpcServer.
+func NewWorkerServiceWithGrpcServer(c client.Client, runtimeOpts ...ServiceWithGrpcServerWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithGrpcServerTaskQueue, opts)
+}
+
+// RegisterServiceWithGrpcServer registers the workflows and activities of ServiceWithGrpcServer
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithGrpcServer(w worker.Registry, impl ServiceWithGrpcServerTemporalClient) {
+	w.RegisterWorkflowWithOptions(func(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+		start := workflow.Now(ctx)
+		out, err := impl.Foo(ctx, in)
+		recordServiceWithGrpcServerMetrics(workflow.GetMetricsHandler(ctx), ServiceWithGrpcServerFooMethod, ServiceWithGrpcServerWorkerSide, workflow.Now(ctx).Sub(start), err)
+		return out, err
+	}, workflow.RegisterOptions{Name: "Foo"})
+	w.RegisterWorkflowWithOptions(func(ctx workflow.Context) error {
+		start := workflow.Now(ctx)
+		err := impl.Bar(ctx)
+		recordServiceWithGrpcServerMetrics(workflow.GetMetricsHandler(ctx), ServiceWithGrpcServerBarMethod, ServiceWithGrpcServerWorkerSide, workflow.Now(ctx).Sub(start), err)
+		return err
+	}, workflow.RegisterOptions{Name: "Bar"})
+	w.RegisterActivityWithOptions(func(ctx context.Context, in *FooInput) (*FooOutput, error) {
+		start := time.Now()
+		out, err := impl.Baz(ctx, in)
+		recordServiceWithGrpcServerMetrics(activity.GetMetricsHandler(ctx), ServiceWithGrpcServerBazMethod, ServiceWithGrpcServerWorkerSide, time.Since(start), err)
+		return out, err
+	}, activity.RegisterOptions{Name: "Baz"})
+}
+
+// StartWorkerServiceWithGrpcServer runs a worker which hosts only ServiceWithGrpcServer,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithGrpcServer(c client.Client, impl ServiceWithGrpcServerTemporalClient, runtimeOpts ...ServiceWithGrpcServerWorkerOption) {
+	w := NewWorkerServiceWithGrpcServer(c, runtimeOpts...)
+	RegisterServiceWithGrpcServer(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// Metrics which the generated helpers of ServiceWithGrpcServer and its registered
+// workflows and activities record with a client.MetricsHandler, tagged by
+// proto service, method, and side (client or worker).
+const (
+	ServiceWithGrpcServerCallsMetric    = "proto_method_calls"
+	ServiceWithGrpcServerFailuresMetric = "proto_method_failures"
+	ServiceWithGrpcServerLatencyMetric  = "proto_method_latency"
+
+	ServiceWithGrpcServerServiceTag = "proto_service"
+	ServiceWithGrpcServerMethodTag  = "proto_method"
+	ServiceWithGrpcServerSideTag    = "proto_side"
+
+	ServiceWithGrpcServerServiceName = "grpcserver.ServiceWithGrpcServer"
+	ServiceWithGrpcServerFooMethod   = "Foo"
+	ServiceWithGrpcServerBarMethod   = "Bar"
+	ServiceWithGrpcServerBazMethod   = "Baz"
+	ServiceWithGrpcServerClientSide  = "client"
+	ServiceWithGrpcServerWorkerSide  = "worker"
+)
+
+// recordServiceWithGrpcServerMetrics records a call of a method of ServiceWithGrpcServer, with its latency
+// and failure (if err isn't nil, and doesn't continue the workflow as new).
+func recordServiceWithGrpcServerMetrics(h client.MetricsHandler, method, side string, latency time.Duration, err error) {
+	if h == nil {
+		return
+	}
+	h = h.WithTags(map[string]string{
+		ServiceWithGrpcServerServiceTag: ServiceWithGrpcServerServiceName,
+		ServiceWithGrpcServerMethodTag:  method,
+		ServiceWithGrpcServerSideTag:    side,
+	})
+	h.Counter(ServiceWithGrpcServerCallsMetric).Inc(1)
+	h.Timer(ServiceWithGrpcServerLatencyMetric).Record(latency)
+	if err != nil && !workflow.IsContinueAsNewError(err) {
+		h.Counter(ServiceWithGrpcServerFailuresMetric).Inc(1)
+	}
+}
+
+// ServiceWithGrpcServer is also served over gRPC.
+type ServiceWithGrpcServerTemporalClient interface {
+	// Foo workflow.
+	Foo(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+	// Bar workflow, without input and output.
+	Bar(ctx workflow.Context) error
+	// Baz activity, which remains unimplemented over gRPC.
+	Baz(ctx context.Context, in *FooInput) (*FooOutput, error)
+}
+
+type serviceWithGrpcServerTemporalClient struct {
+	t client.Client
+	m client.MetricsHandler
+}
+
+// ServiceWithGrpcServer is also served over gRPC.
+//
+// Client helpers record metrics with the given handler, which should be the
+// same as the client's MetricsHandler option (nil disables them). Helpers in
+// workflows use the workflow's handler instead.
+func NewServiceWithGrpcServerTemporalClient(c client.Client, m client.MetricsHandler) *ServiceWithGrpcServerTemporalClient {
+	return newServiceWithGrpcServerTemporalClient(c, m)
+}
+
+func newServiceWithGrpcServerTemporalClient(c client.Client, m client.MetricsHandler) *serviceWithGrpcServerTemporalClient {
+	return &serviceWithGrpcServerTemporalClient{c, m}
+}
+
+// Foo workflow.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithGrpcServerTemporalClient) StartWorkflowServiceWithGrpcServerFoo(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	start := time.Now()
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+	recordServiceWithGrpcServerMetrics(c.m, ServiceWithGrpcServerFooMethod, ServiceWithGrpcServerClientSide, time.Since(start), err)
+	return run, err
+}
+
+// Foo workflow.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithGrpcServerTemporalClient) ExecuteWorkflowServiceWithGrpcServerFoo(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	start := time.Now()
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+	if err != nil {
+		recordServiceWithGrpcServerMetrics(c.m, ServiceWithGrpcServerFooMethod, ServiceWithGrpcServerClientSide, time.Since(start), err)
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	recordServiceWithGrpcServerMetrics(c.m, ServiceWithGrpcServerFooMethod, ServiceWithGrpcServerClientSide, time.Since(start), err)
+	return out, err
+}
+
+// Foo workflow.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithGrpcServerTemporalClient) StartChildWorkflowServiceWithGrpcServerFoo(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Foo, in)
+}
+
+// Foo workflow.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithGrpcServerTemporalClient) ExecuteChildWorkflowServiceWithGrpcServerFoo(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	start := workflow.Now(ctx)
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Foo, in).Get(ctx, &out)
+	recordServiceWithGrpcServerMetrics(workflow.GetMetricsHandler(ctx), ServiceWithGrpcServerFooMethod, ServiceWithGrpcServerClientSide, workflow.Now(ctx).Sub(start), err)
+	return out, err
+}
+
+// Bar workflow, without input and output.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithGrpcServerTemporalClient) StartWorkflowServiceWithGrpcServerBar(ctx context.Context) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	start := time.Now()
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Bar)
+	recordServiceWithGrpcServerMetrics(c.m, ServiceWithGrpcServerBarMethod, ServiceWithGrpcServerClientSide, time.Since(start), err)
+	return run, err
+}
+
+// Bar workflow, without input and output.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithGrpcServerTemporalClient) ExecuteWorkflowServiceWithGrpcServerBar(ctx context.Context) error {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	start := time.Now()
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Bar)
+	if err != nil {
+		recordServiceWithGrpcServerMetrics(c.m, ServiceWithGrpcServerBarMethod, ServiceWithGrpcServerClientSide, time.Since(start), err)
+		return err
+	}
+	err = run.Get(ctx, nil)
+	recordServiceWithGrpcServerMetrics(c.m, ServiceWithGrpcServerBarMethod, ServiceWithGrpcServerClientSide, time.Since(start), err)
+	return err
+}
+
+// Bar workflow, without input and output.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithGrpcServerTemporalClient) StartChildWorkflowServiceWithGrpcServerBar(ctx workflow.Context) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Bar)
+}
+
+// Bar workflow, without input and output.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithGrpcServerTemporalClient) ExecuteChildWorkflowServiceWithGrpcServerBar(ctx workflow.Context) error {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	start := workflow.Now(ctx)
+	err := workflow.ExecuteChildWorkflow(ctx, c.Bar).Get(ctx, nil)
+	recordServiceWithGrpcServerMetrics(workflow.GetMetricsHandler(ctx), ServiceWithGrpcServerBarMethod, ServiceWithGrpcServerClientSide, workflow.Now(ctx).Sub(start), err)
+	return err
+}
+
+// Baz activity, which remains unimplemented over gRPC.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithGrpcServerTemporalClient) StartActivityServiceWithGrpcServerBaz(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Baz, in)
+}
+
+// Baz activity, which remains unimplemented over gRPC.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithGrpcServerTemporalClient) ExecuteActivityServiceWithGrpcServerBaz(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	start := workflow.Now(ctx)
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Baz, in).Get(ctx, &out)
+	recordServiceWithGrpcServerMetrics(workflow.GetMetricsHandler(ctx), ServiceWithGrpcServerBazMethod, ServiceWithGrpcServerClientSide, workflow.Now(ctx).Sub(start), err)
+	return out, err
+}
+
+// Baz activity, which remains unimplemented over gRPC.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithGrpcServerTemporalClient) StartLocalActivityServiceWithGrpcServerBaz(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Baz, in)
+}
+
+// Baz activity, which remains unimplemented over gRPC.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithGrpcServerTemporalClient) ExecuteLocalActivityServiceWithGrpcServerBaz(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	start := workflow.Now(ctx)
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Baz, in).Get(ctx, &out)
+	recordServiceWithGrpcServerMetrics(workflow.GetMetricsHandler(ctx), ServiceWithGrpcServerBazMethod, ServiceWithGrpcServerClientSide, workflow.Now(ctx).Sub(start), err)
+	return out, err
+}
+
+// ContinueAsNewServiceWithGrpcServerFoo returns an error which ends the current run of the Foo
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithGrpcServerFoo(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Foo", in)
+}
+
+// ContinueAsNewServiceWithGrpcServerBar returns an error which ends the current run of the Bar
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithGrpcServerBar(ctx workflow.Context) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Bar")
+}
+
+const (
+	// ServiceWithGrpcServerWorkflowIDHeader is the gRPC response header with the ID of the workflow
+	// which a ServiceWithGrpcServer rpc starts, see WithServiceWithGrpcServerGRPCStartWorkflows.
+	ServiceWithGrpcServerWorkflowIDHeader = "temporal-workflow-id"
+	// ServiceWithGrpcServerRunIDHeader is the gRPC response header with the run ID of the workflow
+	// which a ServiceWithGrpcServer rpc starts, see WithServiceWithGrpcServerGRPCStartWorkflows.
+	ServiceWithGrpcServerRunIDHeader = "temporal-run-id"
+)
+
+type serviceWithGrpcServerGRPCServer struct {
+	UnimplementedServiceWithGrpcServerServer
+	c     *serviceWithGrpcServerTemporalClient
+	start bool
+}
+
+// ServiceWithGrpcServerGRPCServerOption configures the gRPC server of ServiceWithGrpcServer, see NewServiceWithGrpcServerGRPCServer.
+type ServiceWithGrpcServerGRPCServerOption func(*serviceWithGrpcServerGRPCServer)
+
+// WithServiceWithGrpcServerGRPCStartWorkflows makes workflow rpcs return as soon as their
+// workflows start, instead of when they complete. Their responses are empty
+// output messages (not the workflows' outputs, even if they complete at once),
+// and their headers contain the workflow and run IDs, see ServiceWithGrpcServerWorkflowIDHeader
+// and ServiceWithGrpcServerRunIDHeader. Clients can read them with the grpc.Header call option.
+func WithServiceWithGrpcServerGRPCStartWorkflows() ServiceWithGrpcServerGRPCServerOption {
+	return func(s *serviceWithGrpcServerGRPCServer) {
+		s.start = true
+	}
+}
+
+// NewServiceWithGrpcServerGRPCServer returns an implementation of ServiceWithGrpcServerServer (generated
+// by protoc-gen-go-grpc) which runs the workflow of each workflow rpc with the
+// given client, and by default waits for its completion. Temporal errors are
+// converted to gRPC status errors. Other rpcs are unimplemented.
+//
+// The given metrics handler is used like in NewServiceWithGrpcServerTemporalClient.
+func NewServiceWithGrpcServerGRPCServer(c client.Client, m client.MetricsHandler, opts ...ServiceWithGrpcServerGRPCServerOption) ServiceWithGrpcServerServer {
+	s := &serviceWithGrpcServerGRPCServer{c: newServiceWithGrpcServerTemporalClient(c, m)}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+// Foo runs the workflow grpcserver.ServiceWithGrpcServer.Foo, or only starts
+// it with WithServiceWithGrpcServerGRPCStartWorkflows and then returns an empty FooOutput.
+func (s *serviceWithGrpcServerGRPCServer) Foo(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	if s.start {
+		run, err := s.c.StartWorkflowServiceWithGrpcServerFoo(ctx, in)
+		if err != nil {
+			return nil, serviceWithGrpcServerGRPCError(err)
+		}
+		// This fails only outside of gRPC servers, e.g. in unit tests.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(ServiceWithGrpcServerWorkflowIDHeader, run.GetID(), ServiceWithGrpcServerRunIDHeader, run.GetRunID()))
+		return &FooOutput{}, nil
+	}
+	out, err := s.c.ExecuteWorkflowServiceWithGrpcServerFoo(ctx, in)
+	if err != nil {
+		return nil, serviceWithGrpcServerGRPCError(err)
+	}
+	return out, nil
+}
+
+// Bar runs the workflow grpcserver.ServiceWithGrpcServer.Bar, or only starts
+// it with WithServiceWithGrpcServerGRPCStartWorkflows and then returns an empty emptypb.Empty.
+func (s *serviceWithGrpcServerGRPCServer) Bar(ctx context.Context, in *emptypb.Empty) (*emptypb.Empty, error) {
+	if s.start {
+		run, err := s.c.StartWorkflowServiceWithGrpcServerBar(ctx)
+		if err != nil {
+			return nil, serviceWithGrpcServerGRPCError(err)
+		}
+		// This fails only outside of gRPC servers, e.g. in unit tests.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(ServiceWithGrpcServerWorkflowIDHeader, run.GetID(), ServiceWithGrpcServerRunIDHeader, run.GetRunID()))
+		return &emptypb.Empty{}, nil
+	}
+	if err := s.c.ExecuteWorkflowServiceWithGrpcServerBar(ctx); err != nil {
+		return nil, serviceWithGrpcServerGRPCError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// serviceWithGrpcServerGRPCError converts an error of a workflow of ServiceWithGrpcServer to a gRPC
+// status error: workflows which already started are AlreadyExists, timeouts are
+// DeadlineExceeded, cancellations are Canceled, and terminations are Aborted.
+// Application errors are Unknown.
+// Errors of the Temporal service keep their own codes.
+func serviceWithGrpcServerGRPCError(err error) error {
+	var timeoutErr *temporal.TimeoutError
+	var canceledErr *temporal.CanceledError
+	var terminatedErr *temporal.TerminatedError
+	var appErr *temporal.ApplicationError
+	code := serviceerror.ToStatus(err).Code()
+	switch {
+	case temporal.IsWorkflowExecutionAlreadyStartedError(err):
+		code = codes.AlreadyExists
+	case errors.As(err, &timeoutErr):
+		code = codes.DeadlineExceeded
+	case errors.As(err, &canceledErr):
+		code = codes.Canceled
+	case errors.As(err, &terminatedErr):
+		code = codes.Aborted
+	case errors.As(err, &appErr):
+		code = codes.Unknown
+	}
+	return status.Error(code, err.Error())
+}
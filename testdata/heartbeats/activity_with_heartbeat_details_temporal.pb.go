@@ -0,0 +1,332 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: activity_with_heartbeat_details.proto
+
+package heartbeats
+
+import (
+	context "context"
+	activity "go.temporal.io/sdk/activity"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+	time "time"
+)
+
+// ActivityWithHeartbeatDetailsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ActivityWithHeartbeatDetailsWorkerOption func(*worker.Options)
+
+// WithActivityWithHeartbeatDetailsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithActivityWithHeartbeatDetailsBackgroundActivityContext(ctx context.Context) ActivityWithHeartbeatDetailsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithActivityWithHeartbeatDetailsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithActivityWithHeartbeatDetailsInterceptors(interceptors ...interceptor.WorkerInterceptor) ActivityWithHeartbeatDetailsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithActivityWithHeartbeatDetailsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithActivityWithHeartbeatDetailsOnFatalError(f func(error)) ActivityWithHeartbeatDetailsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ActivityWithHeartbeatDetailsTaskQueue is the name of the task queue of the ActivityWithHeartbeatDetails worker.
+const ActivityWithHeartbeatDetailsTaskQueue = "my-task-queue"
+
+// NewWorkerActivityWithHeartbeatDetails creates a worker for the task queue of ActivityWithHeartbeatDetails,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterActivityWithHeartbeatDetails.
+func NewWorkerActivityWithHeartbeatDetails(c client.Client, runtimeOpts ...ActivityWithHeartbeatDetailsWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ActivityWithHeartbeatDetailsTaskQueue, opts)
+}
+
+// RegisterActivityWithHeartbeatDetails registers the workflows and activities of ActivityWithHeartbeatDetails
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterActivityWithHeartbeatDetails(w worker.Registry, impl ActivityWithHeartbeatDetailsTemporalClient) {
+	w.RegisterActivity(impl.Upload)
+	w.RegisterActivity(impl.Download)
+	w.RegisterActivity(impl.Ping)
+}
+
+// StartWorkerActivityWithHeartbeatDetails runs a worker which hosts only ActivityWithHeartbeatDetails,
+// until the process receives an interrupt signal.
+func StartWorkerActivityWithHeartbeatDetails(c client.Client, impl ActivityWithHeartbeatDetailsTemporalClient, runtimeOpts ...ActivityWithHeartbeatDetailsWorkerOption) {
+	w := NewWorkerActivityWithHeartbeatDetails(c, runtimeOpts...)
+	RegisterActivityWithHeartbeatDetails(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+type ActivityWithHeartbeatDetailsTemporalClient interface {
+	// Upload activity, with heartbeat details relative to this package.
+	//
+	// This activity heartbeats with *Progress details, using
+	// RecordHeartbeatActivityWithHeartbeatDetailsUpload and GetHeartbeatActivityWithHeartbeatDetailsUpload.
+	Upload(ctx context.Context, in *FooInput) (*FooOutput, error)
+	// Download activity, with fully-qualified nested heartbeat details.
+	//
+	// This activity heartbeats with *FooInput_Checkpoint details, using
+	// RecordHeartbeatActivityWithHeartbeatDetailsDownload and GetHeartbeatActivityWithHeartbeatDetailsDownload.
+	Download(ctx context.Context, in *FooInput) (*FooOutput, error)
+	// Ping activity, without heartbeats.
+	Ping(ctx context.Context, in *FooInput) (*FooOutput, error)
+}
+
+type activityWithHeartbeatDetailsTemporalClient struct {
+	t client.Client
+}
+
+func NewActivityWithHeartbeatDetailsTemporalClient(c client.Client) *ActivityWithHeartbeatDetailsTemporalClient {
+	return newActivityWithHeartbeatDetailsTemporalClient(c)
+}
+
+func newActivityWithHeartbeatDetailsTemporalClient(c client.Client) *activityWithHeartbeatDetailsTemporalClient {
+	return &activityWithHeartbeatDetailsTemporalClient{c}
+}
+
+// Upload activity, with heartbeat details relative to this package.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *activityWithHeartbeatDetailsTemporalClient) StartActivityActivityWithHeartbeatDetailsUpload(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:        "my-task-queue",
+		HeartbeatTimeout: time.Duration(30 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Upload, in)
+}
+
+// Upload activity, with heartbeat details relative to this package.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *activityWithHeartbeatDetailsTemporalClient) ExecuteActivityActivityWithHeartbeatDetailsUpload(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:        "my-task-queue",
+		HeartbeatTimeout: time.Duration(30 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Upload, in).Get(ctx, &out)
+	return out, err
+}
+
+// Upload activity, with heartbeat details relative to this package.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *activityWithHeartbeatDetailsTemporalClient) StartLocalActivityActivityWithHeartbeatDetailsUpload(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{})
+	return workflow.ExecuteActivity(ctx, c.Upload, in)
+}
+
+// Upload activity, with heartbeat details relative to this package.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *activityWithHeartbeatDetailsTemporalClient) ExecuteLocalActivityActivityWithHeartbeatDetailsUpload(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Upload, in).Get(ctx, &out)
+	return out, err
+}
+
+// Download activity, with fully-qualified nested heartbeat details.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *activityWithHeartbeatDetailsTemporalClient) StartActivityActivityWithHeartbeatDetailsDownload(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:        "my-task-queue",
+		HeartbeatTimeout: time.Duration(30 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Download, in)
+}
+
+// Download activity, with fully-qualified nested heartbeat details.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *activityWithHeartbeatDetailsTemporalClient) ExecuteActivityActivityWithHeartbeatDetailsDownload(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:        "my-task-queue",
+		HeartbeatTimeout: time.Duration(30 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Download, in).Get(ctx, &out)
+	return out, err
+}
+
+// Download activity, with fully-qualified nested heartbeat details.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *activityWithHeartbeatDetailsTemporalClient) StartLocalActivityActivityWithHeartbeatDetailsDownload(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{})
+	return workflow.ExecuteActivity(ctx, c.Download, in)
+}
+
+// Download activity, with fully-qualified nested heartbeat details.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *activityWithHeartbeatDetailsTemporalClient) ExecuteLocalActivityActivityWithHeartbeatDetailsDownload(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Download, in).Get(ctx, &out)
+	return out, err
+}
+
+// Ping activity, without heartbeats.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *activityWithHeartbeatDetailsTemporalClient) StartActivityActivityWithHeartbeatDetailsPing(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Ping, in)
+}
+
+// Ping activity, without heartbeats.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *activityWithHeartbeatDetailsTemporalClient) ExecuteActivityActivityWithHeartbeatDetailsPing(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Ping, in).Get(ctx, &out)
+	return out, err
+}
+
+// Ping activity, without heartbeats.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *activityWithHeartbeatDetailsTemporalClient) StartLocalActivityActivityWithHeartbeatDetailsPing(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Ping, in)
+}
+
+// Ping activity, without heartbeats.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *activityWithHeartbeatDetailsTemporalClient) ExecuteLocalActivityActivityWithHeartbeatDetailsPing(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Ping, in).Get(ctx, &out)
+	return out, err
+}
+
+// RecordHeartbeatActivityWithHeartbeatDetailsUpload reports the progress of the Upload activity to Temporal.
+// The details are available to the next attempt of the activity if the current
+// one fails or times out - see GetHeartbeatActivityWithHeartbeatDetailsUpload. For more information, see
+// https://docs.temporal.io/dev-guide/go/features#activity-heartbeats.
+func RecordHeartbeatActivityWithHeartbeatDetailsUpload(ctx context.Context, details *Progress) {
+	activity.RecordHeartbeat(ctx, details)
+}
+
+// GetHeartbeatActivityWithHeartbeatDetailsUpload returns the details of the last heartbeat which a previous
+// attempt of the Upload activity recorded, and whether there is one.
+func GetHeartbeatActivityWithHeartbeatDetailsUpload(ctx context.Context) (*Progress, bool, error) {
+	if !activity.HasHeartbeatDetails(ctx) {
+		return nil, false, nil
+	}
+	var details *Progress
+	if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+		return nil, false, err
+	}
+	return details, true, nil
+}
+
+// RecordHeartbeatActivityWithHeartbeatDetailsDownload reports the progress of the Download activity to Temporal.
+// The details are available to the next attempt of the activity if the current
+// one fails or times out - see GetHeartbeatActivityWithHeartbeatDetailsDownload. For more information, see
+// https://docs.temporal.io/dev-guide/go/features#activity-heartbeats.
+func RecordHeartbeatActivityWithHeartbeatDetailsDownload(ctx context.Context, details *FooInput_Checkpoint) {
+	activity.RecordHeartbeat(ctx, details)
+}
+
+// GetHeartbeatActivityWithHeartbeatDetailsDownload returns the details of the last heartbeat which a previous
+// attempt of the Download activity recorded, and whether there is one.
+func GetHeartbeatActivityWithHeartbeatDetailsDownload(ctx context.Context) (*FooInput_Checkpoint, bool, error) {
+	if !activity.HasHeartbeatDetails(ctx) {
+		return nil, false, nil
+	}
+	var details *FooInput_Checkpoint
+	if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+		return nil, false, err
+	}
+	return details, true, nil
+}
@@ -0,0 +1,415 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_manifest.proto
+
+package manifest
+
+import (
+	context "context"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	temporal "go.temporal.io/sdk/temporal"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+	time "time"
+)
+
+// ServiceWithManifestWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithManifestWorkerOption func(*worker.Options)
+
+// WithServiceWithManifestBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithManifestBackgroundActivityContext(ctx context.Context) ServiceWithManifestWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithManifestInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithManifestInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithManifestWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithManifestOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithManifestOnFatalError(f func(error)) ServiceWithManifestWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithManifestTaskQueue is the name of the task queue of the ServiceWithManifest worker.
+const ServiceWithManifestTaskQueue = "my-task-queue"
+
+// NewWorkerServiceWithManifest creates a worker for the task queue of ServiceWithManifest,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithManifest.
+func NewWorkerServiceWithManifest(c client.Client, runtimeOpts ...ServiceWithManifestWorkerOption) worker.Worker {
+	opts := worker.Options{
+		MaxConcurrentActivityExecutionSize: 10,
+	}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithManifestTaskQueue, opts)
+}
+
+// RegisterServiceWithManifest registers the workflows and activities of ServiceWithManifest
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithManifest(w worker.Registry, impl ServiceWithManifestTemporalClient) {
+	w.RegisterWorkflow(impl.Foo)
+	w.RegisterActivity(impl.Bar)
+}
+
+// StartWorkerServiceWithManifest runs a worker which hosts only ServiceWithManifest,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithManifest(c client.Client, impl ServiceWithManifestTemporalClient, runtimeOpts ...ServiceWithManifestWorkerOption) {
+	w := NewWorkerServiceWithManifest(c, runtimeOpts...)
+	RegisterServiceWithManifest(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+type ServiceWithManifestTemporalClient interface {
+	// Foo workflow.
+	Foo(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+	// Bar activity.
+	Bar(ctx context.Context, in *FooInput) error
+}
+
+type serviceWithManifestTemporalClient struct {
+	t client.Client
+}
+
+func NewServiceWithManifestTemporalClient(c client.Client) *ServiceWithManifestTemporalClient {
+	return newServiceWithManifestTemporalClient(c)
+}
+
+func newServiceWithManifestTemporalClient(c client.Client) *serviceWithManifestTemporalClient {
+	return &serviceWithManifestTemporalClient{c}
+}
+
+// Foo workflow.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithManifestTemporalClient) StartWorkflowServiceWithManifestFoo(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+			NonRetryableErrorTypes: []string{
+				"Fatal",
+			},
+		},
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+}
+
+// Foo workflow.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithManifestTemporalClient) ExecuteWorkflowServiceWithManifestFoo(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+			NonRetryableErrorTypes: []string{
+				"Fatal",
+			},
+		},
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Foo workflow.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithManifestTemporalClient) StartChildWorkflowServiceWithManifestFoo(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+			NonRetryableErrorTypes: []string{
+				"Fatal",
+			},
+		},
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Foo, in)
+}
+
+// Foo workflow.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithManifestTemporalClient) ExecuteChildWorkflowServiceWithManifestFoo(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue:                "my-task-queue",
+		WorkflowExecutionTimeout: time.Duration(3600 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 3,
+			NonRetryableErrorTypes: []string{
+				"Fatal",
+			},
+		},
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Foo, in).Get(ctx, &out)
+	return out, err
+}
+
+// Bar activity.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithManifestTemporalClient) StartActivityServiceWithManifestBar(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "bar-task-queue",
+		StartToCloseTimeout: time.Duration(1.5 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithManifestTemporalClient) ExecuteActivityServiceWithManifestBar(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "bar-task-queue",
+		StartToCloseTimeout: time.Duration(1.5 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in).Get(ctx, nil)
+}
+
+// Bar activity.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithManifestTemporalClient) StartLocalActivityServiceWithManifestBar(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(1.5 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithManifestTemporalClient) ExecuteLocalActivityServiceWithManifestBar(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(1.5 * float64(time.Second)),
+	})
+	return workflow.ExecuteLocalActivity(ctx, c.Bar, in).Get(ctx, nil)
+}
+
+// ContinueAsNewServiceWithManifestFoo returns an error which ends the current run of the Foo
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithManifestFoo(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Foo", in)
+}
+
+// DeprecatedServiceWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type DeprecatedServiceWorkerOption func(*worker.Options)
+
+// WithDeprecatedServiceBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithDeprecatedServiceBackgroundActivityContext(ctx context.Context) DeprecatedServiceWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithDeprecatedServiceInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithDeprecatedServiceInterceptors(interceptors ...interceptor.WorkerInterceptor) DeprecatedServiceWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithDeprecatedServiceOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithDeprecatedServiceOnFatalError(f func(error)) DeprecatedServiceWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// DeprecatedServiceTaskQueue is the name of the task queue of the DeprecatedService worker.
+const DeprecatedServiceTaskQueue = "other-task-queue"
+
+// NewWorkerDeprecatedService creates a worker for the task queue of DeprecatedService,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterDeprecatedService.
+func NewWorkerDeprecatedService(c client.Client, runtimeOpts ...DeprecatedServiceWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, DeprecatedServiceTaskQueue, opts)
+}
+
+// RegisterDeprecatedService registers the workflows and activities of DeprecatedService
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterDeprecatedService(w worker.Registry, impl DeprecatedServiceTemporalClient) {
+	w.RegisterActivity(impl.Baz)
+}
+
+// StartWorkerDeprecatedService runs a worker which hosts only DeprecatedService,
+// until the process receives an interrupt signal.
+func StartWorkerDeprecatedService(c client.Client, impl DeprecatedServiceTemporalClient, runtimeOpts ...DeprecatedServiceWorkerOption) {
+	w := NewWorkerDeprecatedService(c, runtimeOpts...)
+	RegisterDeprecatedService(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// Deprecated: Do not use.
+type DeprecatedServiceTemporalClient interface {
+	// Baz activity, which is deprecated.
+	//
+	// Deprecated: Do not use.
+	Baz(ctx context.Context, in *FooInput) (*FooOutput, error)
+}
+
+type deprecatedServiceTemporalClient struct {
+	t client.Client
+}
+
+// Deprecated: Do not use.
+func NewDeprecatedServiceTemporalClient(c client.Client) *DeprecatedServiceTemporalClient {
+	return newDeprecatedServiceTemporalClient(c)
+}
+
+func newDeprecatedServiceTemporalClient(c client.Client) *deprecatedServiceTemporalClient {
+	return &deprecatedServiceTemporalClient{c}
+}
+
+// Baz activity, which is deprecated.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+//
+// Deprecated: Do not use.
+func (c *deprecatedServiceTemporalClient) StartActivityDeprecatedServiceBaz(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:              "other-task-queue",
+		ScheduleToCloseTimeout: time.Duration(60 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Baz, in)
+}
+
+// Baz activity, which is deprecated.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+//
+// Deprecated: Do not use.
+func (c *deprecatedServiceTemporalClient) ExecuteActivityDeprecatedServiceBaz(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:              "other-task-queue",
+		ScheduleToCloseTimeout: time.Duration(60 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Baz, in).Get(ctx, &out)
+	return out, err
+}
+
+// Baz activity, which is deprecated.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+//
+// Deprecated: Do not use.
+func (c *deprecatedServiceTemporalClient) StartLocalActivityDeprecatedServiceBaz(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		ScheduleToCloseTimeout: time.Duration(60 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Baz, in)
+}
+
+// Baz activity, which is deprecated.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+//
+// Deprecated: Do not use.
+func (c *deprecatedServiceTemporalClient) ExecuteLocalActivityDeprecatedServiceBaz(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		ScheduleToCloseTimeout: time.Duration(60 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Baz, in).Get(ctx, &out)
+	return out, err
+}
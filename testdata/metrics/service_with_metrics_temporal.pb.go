@@ -0,0 +1,754 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_metrics.proto
+
+package metrics
+
+import (
+	context "context"
+	tracing "github.com/daabr/protoc-gen-temporal-go/tracing"
+	trace "go.opentelemetry.io/otel/trace"
+	activity "go.temporal.io/sdk/activity"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+	time "time"
+)
+
+// ServiceWithMetricsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithMetricsWorkerOption func(*worker.Options)
+
+// WithServiceWithMetricsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithMetricsBackgroundActivityContext(ctx context.Context) ServiceWithMetricsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithMetricsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithMetricsInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithMetricsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithMetricsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithMetricsOnFatalError(f func(error)) ServiceWithMetricsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithMetricsTaskQueue is the name of the task queue of the ServiceWithMetrics worker.
+const ServiceWithMetricsTaskQueue = "my-task-queue"
+
+// NewWorkerServiceWithMetrics creates a worker for the task queue of ServiceWithMetrics,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithMetrics.
+func NewWorkerServiceWithMetrics(c client.Client, runtimeOpts ...ServiceWithMetricsWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithMetricsTaskQueue, opts)
+}
+
+// RegisterServiceWithMetrics registers the workflows and activities of ServiceWithMetrics
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithMetrics(w worker.Registry, impl ServiceWithMetricsTemporalClient) {
+	w.RegisterWorkflowWithOptions(func(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+		start := workflow.Now(ctx)
+		out, err := impl.Foo(ctx, in)
+		recordServiceWithMetricsMetrics(workflow.GetMetricsHandler(ctx), ServiceWithMetricsFooMethod, ServiceWithMetricsWorkerSide, workflow.Now(ctx).Sub(start), err)
+		return out, err
+	}, workflow.RegisterOptions{Name: "Foo"})
+	w.RegisterWorkflowWithOptions(func(ctx workflow.Context, in *FooInput) error {
+		start := workflow.Now(ctx)
+		err := impl.Notify(ctx, in)
+		recordServiceWithMetricsMetrics(workflow.GetMetricsHandler(ctx), ServiceWithMetricsNotifyMethod, ServiceWithMetricsWorkerSide, workflow.Now(ctx).Sub(start), err)
+		return err
+	}, workflow.RegisterOptions{Name: "Notify"})
+	w.RegisterActivityWithOptions(func(ctx context.Context, in *FooInput) (*FooOutput, error) {
+		start := time.Now()
+		out, err := impl.Bar(ctx, in)
+		recordServiceWithMetricsMetrics(activity.GetMetricsHandler(ctx), ServiceWithMetricsBarMethod, ServiceWithMetricsWorkerSide, time.Since(start), err)
+		return out, err
+	}, activity.RegisterOptions{Name: "Bar"})
+	w.RegisterActivityWithOptions(func(ctx context.Context, in *FooInput) error {
+		start := time.Now()
+		err := impl.Baz(ctx, in)
+		recordServiceWithMetricsMetrics(activity.GetMetricsHandler(ctx), ServiceWithMetricsBazMethod, ServiceWithMetricsWorkerSide, time.Since(start), err)
+		return err
+	}, activity.RegisterOptions{Name: "Baz"})
+}
+
+// StartWorkerServiceWithMetrics runs a worker which hosts only ServiceWithMetrics,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithMetrics(c client.Client, impl ServiceWithMetricsTemporalClient, runtimeOpts ...ServiceWithMetricsWorkerOption) {
+	w := NewWorkerServiceWithMetrics(c, runtimeOpts...)
+	RegisterServiceWithMetrics(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// Metrics which the generated helpers of ServiceWithMetrics and its registered
+// workflows and activities record with a client.MetricsHandler, tagged by
+// proto service, method, and side (client or worker).
+const (
+	ServiceWithMetricsCallsMetric    = "proto_method_calls"
+	ServiceWithMetricsFailuresMetric = "proto_method_failures"
+	ServiceWithMetricsLatencyMetric  = "proto_method_latency"
+
+	ServiceWithMetricsServiceTag = "proto_service"
+	ServiceWithMetricsMethodTag  = "proto_method"
+	ServiceWithMetricsSideTag    = "proto_side"
+
+	ServiceWithMetricsServiceName  = "metrics.ServiceWithMetrics"
+	ServiceWithMetricsFooMethod    = "Foo"
+	ServiceWithMetricsNotifyMethod = "Notify"
+	ServiceWithMetricsBarMethod    = "Bar"
+	ServiceWithMetricsBazMethod    = "Baz"
+	ServiceWithMetricsClientSide   = "client"
+	ServiceWithMetricsWorkerSide   = "worker"
+)
+
+// recordServiceWithMetricsMetrics records a call of a method of ServiceWithMetrics, with its latency
+// and failure (if err isn't nil, and doesn't continue the workflow as new).
+func recordServiceWithMetricsMetrics(h client.MetricsHandler, method, side string, latency time.Duration, err error) {
+	if h == nil {
+		return
+	}
+	h = h.WithTags(map[string]string{
+		ServiceWithMetricsServiceTag: ServiceWithMetricsServiceName,
+		ServiceWithMetricsMethodTag:  method,
+		ServiceWithMetricsSideTag:    side,
+	})
+	h.Counter(ServiceWithMetricsCallsMetric).Inc(1)
+	h.Timer(ServiceWithMetricsLatencyMetric).Record(latency)
+	if err != nil && !workflow.IsContinueAsNewError(err) {
+		h.Counter(ServiceWithMetricsFailuresMetric).Inc(1)
+	}
+}
+
+type ServiceWithMetricsTemporalClient interface {
+	// Foo workflow.
+	Foo(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+	// Notify workflow, which returns no output.
+	Notify(ctx workflow.Context, in *FooInput) error
+	// Bar activity.
+	Bar(ctx context.Context, in *FooInput) (*FooOutput, error)
+	// Baz activity, which returns no output.
+	Baz(ctx context.Context, in *FooInput) error
+}
+
+type serviceWithMetricsTemporalClient struct {
+	t client.Client
+	m client.MetricsHandler
+}
+
+// Client helpers record metrics with the given handler, which should be the
+// same as the client's MetricsHandler option (nil disables them). Helpers in
+// workflows use the workflow's handler instead.
+func NewServiceWithMetricsTemporalClient(c client.Client, m client.MetricsHandler) *ServiceWithMetricsTemporalClient {
+	return newServiceWithMetricsTemporalClient(c, m)
+}
+
+func newServiceWithMetricsTemporalClient(c client.Client, m client.MetricsHandler) *serviceWithMetricsTemporalClient {
+	return &serviceWithMetricsTemporalClient{c, m}
+}
+
+// Foo workflow.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithMetricsTemporalClient) StartWorkflowServiceWithMetricsFoo(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	start := time.Now()
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+	recordServiceWithMetricsMetrics(c.m, ServiceWithMetricsFooMethod, ServiceWithMetricsClientSide, time.Since(start), err)
+	return run, err
+}
+
+// Foo workflow.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithMetricsTemporalClient) ExecuteWorkflowServiceWithMetricsFoo(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	start := time.Now()
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+	if err != nil {
+		recordServiceWithMetricsMetrics(c.m, ServiceWithMetricsFooMethod, ServiceWithMetricsClientSide, time.Since(start), err)
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	recordServiceWithMetricsMetrics(c.m, ServiceWithMetricsFooMethod, ServiceWithMetricsClientSide, time.Since(start), err)
+	return out, err
+}
+
+// Foo workflow.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithMetricsTemporalClient) StartChildWorkflowServiceWithMetricsFoo(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Foo, in)
+}
+
+// Foo workflow.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithMetricsTemporalClient) ExecuteChildWorkflowServiceWithMetricsFoo(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	start := workflow.Now(ctx)
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Foo, in).Get(ctx, &out)
+	recordServiceWithMetricsMetrics(workflow.GetMetricsHandler(ctx), ServiceWithMetricsFooMethod, ServiceWithMetricsClientSide, workflow.Now(ctx).Sub(start), err)
+	return out, err
+}
+
+// Notify workflow, which returns no output.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithMetricsTemporalClient) StartWorkflowServiceWithMetricsNotify(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	start := time.Now()
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Notify, in)
+	recordServiceWithMetricsMetrics(c.m, ServiceWithMetricsNotifyMethod, ServiceWithMetricsClientSide, time.Since(start), err)
+	return run, err
+}
+
+// Notify workflow, which returns no output.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithMetricsTemporalClient) ExecuteWorkflowServiceWithMetricsNotify(ctx context.Context, in *FooInput) error {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	start := time.Now()
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Notify, in)
+	if err != nil {
+		recordServiceWithMetricsMetrics(c.m, ServiceWithMetricsNotifyMethod, ServiceWithMetricsClientSide, time.Since(start), err)
+		return err
+	}
+	err = run.Get(ctx, nil)
+	recordServiceWithMetricsMetrics(c.m, ServiceWithMetricsNotifyMethod, ServiceWithMetricsClientSide, time.Since(start), err)
+	return err
+}
+
+// Notify workflow, which returns no output.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithMetricsTemporalClient) StartChildWorkflowServiceWithMetricsNotify(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Notify, in)
+}
+
+// Notify workflow, which returns no output.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithMetricsTemporalClient) ExecuteChildWorkflowServiceWithMetricsNotify(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	start := workflow.Now(ctx)
+	err := workflow.ExecuteChildWorkflow(ctx, c.Notify, in).Get(ctx, nil)
+	recordServiceWithMetricsMetrics(workflow.GetMetricsHandler(ctx), ServiceWithMetricsNotifyMethod, ServiceWithMetricsClientSide, workflow.Now(ctx).Sub(start), err)
+	return err
+}
+
+// Bar activity.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithMetricsTemporalClient) StartActivityServiceWithMetricsBar(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithMetricsTemporalClient) ExecuteActivityServiceWithMetricsBar(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	start := workflow.Now(ctx)
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Bar, in).Get(ctx, &out)
+	recordServiceWithMetricsMetrics(workflow.GetMetricsHandler(ctx), ServiceWithMetricsBarMethod, ServiceWithMetricsClientSide, workflow.Now(ctx).Sub(start), err)
+	return out, err
+}
+
+// Bar activity.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithMetricsTemporalClient) StartLocalActivityServiceWithMetricsBar(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithMetricsTemporalClient) ExecuteLocalActivityServiceWithMetricsBar(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	start := workflow.Now(ctx)
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Bar, in).Get(ctx, &out)
+	recordServiceWithMetricsMetrics(workflow.GetMetricsHandler(ctx), ServiceWithMetricsBarMethod, ServiceWithMetricsClientSide, workflow.Now(ctx).Sub(start), err)
+	return out, err
+}
+
+// Baz activity, which returns no output.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithMetricsTemporalClient) StartActivityServiceWithMetricsBaz(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Baz, in)
+}
+
+// Baz activity, which returns no output.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithMetricsTemporalClient) ExecuteActivityServiceWithMetricsBaz(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	start := workflow.Now(ctx)
+	err := workflow.ExecuteActivity(ctx, c.Baz, in).Get(ctx, nil)
+	recordServiceWithMetricsMetrics(workflow.GetMetricsHandler(ctx), ServiceWithMetricsBazMethod, ServiceWithMetricsClientSide, workflow.Now(ctx).Sub(start), err)
+	return err
+}
+
+// Baz activity, which returns no output.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithMetricsTemporalClient) StartLocalActivityServiceWithMetricsBaz(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Baz, in)
+}
+
+// Baz activity, which returns no output.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithMetricsTemporalClient) ExecuteLocalActivityServiceWithMetricsBaz(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	start := workflow.Now(ctx)
+	err := workflow.ExecuteLocalActivity(ctx, c.Baz, in).Get(ctx, nil)
+	recordServiceWithMetricsMetrics(workflow.GetMetricsHandler(ctx), ServiceWithMetricsBazMethod, ServiceWithMetricsClientSide, workflow.Now(ctx).Sub(start), err)
+	return err
+}
+
+// ContinueAsNewServiceWithMetricsFoo returns an error which ends the current run of the Foo
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithMetricsFoo(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Foo", in)
+}
+
+// ContinueAsNewServiceWithMetricsNotify returns an error which ends the current run of the Notify
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithMetricsNotify(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Notify", in)
+}
+
+// ServiceWithMetricsAndTracingWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithMetricsAndTracingWorkerOption func(*worker.Options)
+
+// WithServiceWithMetricsAndTracingBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithMetricsAndTracingBackgroundActivityContext(ctx context.Context) ServiceWithMetricsAndTracingWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithMetricsAndTracingInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithMetricsAndTracingInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithMetricsAndTracingWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithMetricsAndTracingOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithMetricsAndTracingOnFatalError(f func(error)) ServiceWithMetricsAndTracingWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithMetricsAndTracingTaskQueue is the name of the task queue of the ServiceWithMetricsAndTracing worker.
+const ServiceWithMetricsAndTracingTaskQueue = "other-task-queue"
+
+// NewWorkerServiceWithMetricsAndTracing creates a worker for the task queue of ServiceWithMetricsAndTracing,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithMetricsAndTracing.
+func NewWorkerServiceWithMetricsAndTracing(c client.Client, runtimeOpts ...ServiceWithMetricsAndTracingWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithMetricsAndTracingTaskQueue, opts)
+}
+
+// RegisterServiceWithMetricsAndTracing registers the workflows and activities of ServiceWithMetricsAndTracing
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithMetricsAndTracing(w worker.Registry, impl ServiceWithMetricsAndTracingTemporalClient) {
+	w.RegisterWorkflowWithOptions(func(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+		start := workflow.Now(ctx)
+		out, err := impl.Qux(ctx, in)
+		recordServiceWithMetricsAndTracingMetrics(workflow.GetMetricsHandler(ctx), ServiceWithMetricsAndTracingQuxMethod, ServiceWithMetricsAndTracingWorkerSide, workflow.Now(ctx).Sub(start), err)
+		return out, err
+	}, workflow.RegisterOptions{Name: "Qux"})
+	w.RegisterActivityWithOptions(func(ctx context.Context, in *FooInput) (*FooOutput, error) {
+		ctx, span := serviceWithMetricsAndTracingQuuxTracing.Start(ctx, trace.SpanKindServer)
+		start := time.Now()
+		tracing.SetWorkflowID(span, activity.GetInfo(ctx).WorkflowExecution.ID)
+		out, err := impl.Quux(ctx, in)
+		recordServiceWithMetricsAndTracingMetrics(activity.GetMetricsHandler(ctx), ServiceWithMetricsAndTracingQuuxMethod, ServiceWithMetricsAndTracingWorkerSide, time.Since(start), err)
+		tracing.End(span, err)
+		return out, err
+	}, activity.RegisterOptions{Name: "Quux"})
+}
+
+// StartWorkerServiceWithMetricsAndTracing runs a worker which hosts only ServiceWithMetricsAndTracing,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithMetricsAndTracing(c client.Client, impl ServiceWithMetricsAndTracingTemporalClient, runtimeOpts ...ServiceWithMetricsAndTracingWorkerOption) {
+	w := NewWorkerServiceWithMetricsAndTracing(c, runtimeOpts...)
+	RegisterServiceWithMetricsAndTracing(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// serviceWithMetricsAndTracingQuxTracing describes the spans of Qux.
+var serviceWithMetricsAndTracingQuxTracing = tracing.Method{
+	FullName:   "metrics.ServiceWithMetricsAndTracing.Qux",
+	Service:    "metrics.ServiceWithMetricsAndTracing",
+	TaskQueue:  "other-task-queue",
+	InputType:  "metrics.FooInput",
+	OutputType: "metrics.FooOutput",
+}
+
+// serviceWithMetricsAndTracingQuuxTracing describes the spans of Quux.
+var serviceWithMetricsAndTracingQuuxTracing = tracing.Method{
+	FullName:   "metrics.ServiceWithMetricsAndTracing.Quux",
+	Service:    "metrics.ServiceWithMetricsAndTracing",
+	TaskQueue:  "other-task-queue",
+	InputType:  "metrics.FooInput",
+	OutputType: "metrics.FooOutput",
+}
+
+// Metrics which the generated helpers of ServiceWithMetricsAndTracing and its registered
+// workflows and activities record with a client.MetricsHandler, tagged by
+// proto service, method, and side (client or worker).
+const (
+	ServiceWithMetricsAndTracingCallsMetric    = "proto_method_calls"
+	ServiceWithMetricsAndTracingFailuresMetric = "proto_method_failures"
+	ServiceWithMetricsAndTracingLatencyMetric  = "proto_method_latency"
+
+	ServiceWithMetricsAndTracingServiceTag = "proto_service"
+	ServiceWithMetricsAndTracingMethodTag  = "proto_method"
+	ServiceWithMetricsAndTracingSideTag    = "proto_side"
+
+	ServiceWithMetricsAndTracingServiceName = "metrics.ServiceWithMetricsAndTracing"
+	ServiceWithMetricsAndTracingQuxMethod   = "Qux"
+	ServiceWithMetricsAndTracingQuuxMethod  = "Quux"
+	ServiceWithMetricsAndTracingClientSide  = "client"
+	ServiceWithMetricsAndTracingWorkerSide  = "worker"
+)
+
+// recordServiceWithMetricsAndTracingMetrics records a call of a method of ServiceWithMetricsAndTracing, with its latency
+// and failure (if err isn't nil, and doesn't continue the workflow as new).
+func recordServiceWithMetricsAndTracingMetrics(h client.MetricsHandler, method, side string, latency time.Duration, err error) {
+	if h == nil {
+		return
+	}
+	h = h.WithTags(map[string]string{
+		ServiceWithMetricsAndTracingServiceTag: ServiceWithMetricsAndTracingServiceName,
+		ServiceWithMetricsAndTracingMethodTag:  method,
+		ServiceWithMetricsAndTracingSideTag:    side,
+	})
+	h.Counter(ServiceWithMetricsAndTracingCallsMetric).Inc(1)
+	h.Timer(ServiceWithMetricsAndTracingLatencyMetric).Record(latency)
+	if err != nil && !workflow.IsContinueAsNewError(err) {
+		h.Counter(ServiceWithMetricsAndTracingFailuresMetric).Inc(1)
+	}
+}
+
+type ServiceWithMetricsAndTracingTemporalClient interface {
+	// Qux workflow.
+	Qux(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+	// Quux activity.
+	Quux(ctx context.Context, in *FooInput) (*FooOutput, error)
+}
+
+type serviceWithMetricsAndTracingTemporalClient struct {
+	t client.Client
+	m client.MetricsHandler
+}
+
+// Client helpers record metrics with the given handler, which should be the
+// same as the client's MetricsHandler option (nil disables them). Helpers in
+// workflows use the workflow's handler instead.
+func NewServiceWithMetricsAndTracingTemporalClient(c client.Client, m client.MetricsHandler) *ServiceWithMetricsAndTracingTemporalClient {
+	return newServiceWithMetricsAndTracingTemporalClient(c, m)
+}
+
+func newServiceWithMetricsAndTracingTemporalClient(c client.Client, m client.MetricsHandler) *serviceWithMetricsAndTracingTemporalClient {
+	return &serviceWithMetricsAndTracingTemporalClient{c, m}
+}
+
+// Qux workflow.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithMetricsAndTracingTemporalClient) StartWorkflowServiceWithMetricsAndTracingQux(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "other-task-queue",
+	}
+	ctx, span := serviceWithMetricsAndTracingQuxTracing.Start(ctx, trace.SpanKindClient)
+	start := time.Now()
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Qux, in)
+	if err == nil {
+		tracing.SetWorkflowID(span, run.GetID())
+	}
+	recordServiceWithMetricsAndTracingMetrics(c.m, ServiceWithMetricsAndTracingQuxMethod, ServiceWithMetricsAndTracingClientSide, time.Since(start), err)
+	tracing.End(span, err)
+	return run, err
+}
+
+// Qux workflow.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithMetricsAndTracingTemporalClient) ExecuteWorkflowServiceWithMetricsAndTracingQux(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "other-task-queue",
+	}
+	ctx, span := serviceWithMetricsAndTracingQuxTracing.Start(ctx, trace.SpanKindClient)
+	start := time.Now()
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Qux, in)
+	if err != nil {
+		recordServiceWithMetricsAndTracingMetrics(c.m, ServiceWithMetricsAndTracingQuxMethod, ServiceWithMetricsAndTracingClientSide, time.Since(start), err)
+		tracing.End(span, err)
+		return nil, err
+	}
+	tracing.SetWorkflowID(span, run.GetID())
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	recordServiceWithMetricsAndTracingMetrics(c.m, ServiceWithMetricsAndTracingQuxMethod, ServiceWithMetricsAndTracingClientSide, time.Since(start), err)
+	tracing.End(span, err)
+	return out, err
+}
+
+// Qux workflow.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithMetricsAndTracingTemporalClient) StartChildWorkflowServiceWithMetricsAndTracingQux(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "other-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Qux, in)
+}
+
+// Qux workflow.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithMetricsAndTracingTemporalClient) ExecuteChildWorkflowServiceWithMetricsAndTracingQux(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "other-task-queue",
+	})
+	start := workflow.Now(ctx)
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Qux, in).Get(ctx, &out)
+	recordServiceWithMetricsAndTracingMetrics(workflow.GetMetricsHandler(ctx), ServiceWithMetricsAndTracingQuxMethod, ServiceWithMetricsAndTracingClientSide, workflow.Now(ctx).Sub(start), err)
+	return out, err
+}
+
+// Quux activity.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithMetricsAndTracingTemporalClient) StartActivityServiceWithMetricsAndTracingQuux(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "other-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Quux, in)
+}
+
+// Quux activity.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithMetricsAndTracingTemporalClient) ExecuteActivityServiceWithMetricsAndTracingQuux(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "other-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	start := workflow.Now(ctx)
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Quux, in).Get(ctx, &out)
+	recordServiceWithMetricsAndTracingMetrics(workflow.GetMetricsHandler(ctx), ServiceWithMetricsAndTracingQuuxMethod, ServiceWithMetricsAndTracingClientSide, workflow.Now(ctx).Sub(start), err)
+	return out, err
+}
+
+// Quux activity.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithMetricsAndTracingTemporalClient) StartLocalActivityServiceWithMetricsAndTracingQuux(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Quux, in)
+}
+
+// Quux activity.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithMetricsAndTracingTemporalClient) ExecuteLocalActivityServiceWithMetricsAndTracingQuux(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	start := workflow.Now(ctx)
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Quux, in).Get(ctx, &out)
+	recordServiceWithMetricsAndTracingMetrics(workflow.GetMetricsHandler(ctx), ServiceWithMetricsAndTracingQuuxMethod, ServiceWithMetricsAndTracingClientSide, workflow.Now(ctx).Sub(start), err)
+	return out, err
+}
+
+// ContinueAsNewServiceWithMetricsAndTracingQux returns an error which ends the current run of the Qux
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithMetricsAndTracingQux(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "other-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Qux", in)
+}
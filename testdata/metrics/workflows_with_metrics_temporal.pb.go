@@ -0,0 +1,264 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: workflows_with_metrics.proto
+
+package metrics
+
+import (
+	context "context"
+	tracing "github.com/daabr/protoc-gen-temporal-go/tracing"
+	trace "go.opentelemetry.io/otel/trace"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+	time "time"
+)
+
+// WorkflowsWithMetricsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type WorkflowsWithMetricsWorkerOption func(*worker.Options)
+
+// WithWorkflowsWithMetricsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithWorkflowsWithMetricsBackgroundActivityContext(ctx context.Context) WorkflowsWithMetricsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithWorkflowsWithMetricsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithWorkflowsWithMetricsInterceptors(interceptors ...interceptor.WorkerInterceptor) WorkflowsWithMetricsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithWorkflowsWithMetricsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithWorkflowsWithMetricsOnFatalError(f func(error)) WorkflowsWithMetricsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// WorkflowsWithMetricsTaskQueue is the name of the task queue of the WorkflowsWithMetrics worker.
+const WorkflowsWithMetricsTaskQueue = "workflows-task-queue"
+
+// NewWorkerWorkflowsWithMetrics creates a worker for the task queue of WorkflowsWithMetrics,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterWorkflowsWithMetrics.
+func NewWorkerWorkflowsWithMetrics(c client.Client, runtimeOpts ...WorkflowsWithMetricsWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, WorkflowsWithMetricsTaskQueue, opts)
+}
+
+// RegisterWorkflowsWithMetrics registers the workflows and activities of WorkflowsWithMetrics
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterWorkflowsWithMetrics(w worker.Registry, impl WorkflowsWithMetricsTemporalClient) {
+	w.RegisterWorkflowWithOptions(func(ctx workflow.Context, in *QuxInput) (*QuxOutput, error) {
+		start := workflow.Now(ctx)
+		out, err := impl.Qux(ctx, in)
+		recordWorkflowsWithMetricsMetrics(workflow.GetMetricsHandler(ctx), WorkflowsWithMetricsQuxMethod, WorkflowsWithMetricsWorkerSide, workflow.Now(ctx).Sub(start), err)
+		return out, err
+	}, workflow.RegisterOptions{Name: "Qux"})
+}
+
+// StartWorkerWorkflowsWithMetrics runs a worker which hosts only WorkflowsWithMetrics,
+// until the process receives an interrupt signal.
+func StartWorkerWorkflowsWithMetrics(c client.Client, impl WorkflowsWithMetricsTemporalClient, runtimeOpts ...WorkflowsWithMetricsWorkerOption) {
+	w := NewWorkerWorkflowsWithMetrics(c, runtimeOpts...)
+	RegisterWorkflowsWithMetrics(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// workflowsWithMetricsQuxTracing describes the spans of Qux.
+var workflowsWithMetricsQuxTracing = tracing.Method{
+	FullName:   "metrics.WorkflowsWithMetrics.Qux",
+	Service:    "metrics.WorkflowsWithMetrics",
+	TaskQueue:  "workflows-task-queue",
+	InputType:  "metrics.QuxInput",
+	OutputType: "metrics.QuxOutput",
+}
+
+// Metrics which the generated helpers of WorkflowsWithMetrics and its registered
+// workflows and activities record with a client.MetricsHandler, tagged by
+// proto service, method, and side (client or worker).
+const (
+	WorkflowsWithMetricsCallsMetric    = "proto_method_calls"
+	WorkflowsWithMetricsFailuresMetric = "proto_method_failures"
+	WorkflowsWithMetricsLatencyMetric  = "proto_method_latency"
+
+	WorkflowsWithMetricsServiceTag = "proto_service"
+	WorkflowsWithMetricsMethodTag  = "proto_method"
+	WorkflowsWithMetricsSideTag    = "proto_side"
+
+	WorkflowsWithMetricsServiceName = "metrics.WorkflowsWithMetrics"
+	WorkflowsWithMetricsQuxMethod   = "Qux"
+	WorkflowsWithMetricsClientSide  = "client"
+	WorkflowsWithMetricsWorkerSide  = "worker"
+)
+
+// recordWorkflowsWithMetricsMetrics records a call of a method of WorkflowsWithMetrics, with its latency
+// and failure (if err isn't nil, and doesn't continue the workflow as new).
+func recordWorkflowsWithMetricsMetrics(h client.MetricsHandler, method, side string, latency time.Duration, err error) {
+	if h == nil {
+		return
+	}
+	h = h.WithTags(map[string]string{
+		WorkflowsWithMetricsServiceTag: WorkflowsWithMetricsServiceName,
+		WorkflowsWithMetricsMethodTag:  method,
+		WorkflowsWithMetricsSideTag:    side,
+	})
+	h.Counter(WorkflowsWithMetricsCallsMetric).Inc(1)
+	h.Timer(WorkflowsWithMetricsLatencyMetric).Record(latency)
+	if err != nil && !workflow.IsContinueAsNewError(err) {
+		h.Counter(WorkflowsWithMetricsFailuresMetric).Inc(1)
+	}
+}
+
+// WorkflowsWithMetrics has only workflows, which are registered with wrappers
+// that record metrics, so the generated file doesn't import the activity
+// package.
+type WorkflowsWithMetricsTemporalClient interface {
+	// Qux workflow.
+	Qux(ctx workflow.Context, in *QuxInput) (*QuxOutput, error)
+}
+
+type workflowsWithMetricsTemporalClient struct {
+	t client.Client
+	m client.MetricsHandler
+}
+
+// WorkflowsWithMetrics has only workflows, which are registered with wrappers
+// that record metrics, so the generated file doesn't import the activity
+// package.
+//
+// Client helpers record metrics with the given handler, which should be the
+// same as the client's MetricsHandler option (nil disables them). Helpers in
+// workflows use the workflow's handler instead.
+func NewWorkflowsWithMetricsTemporalClient(c client.Client, m client.MetricsHandler) *WorkflowsWithMetricsTemporalClient {
+	return newWorkflowsWithMetricsTemporalClient(c, m)
+}
+
+func newWorkflowsWithMetricsTemporalClient(c client.Client, m client.MetricsHandler) *workflowsWithMetricsTemporalClient {
+	return &workflowsWithMetricsTemporalClient{c, m}
+}
+
+// Qux workflow.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *workflowsWithMetricsTemporalClient) StartWorkflowWorkflowsWithMetricsQux(ctx context.Context, in *QuxInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "workflows-task-queue",
+	}
+	ctx, span := workflowsWithMetricsQuxTracing.Start(ctx, trace.SpanKindClient)
+	start := time.Now()
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Qux, in)
+	if err == nil {
+		tracing.SetWorkflowID(span, run.GetID())
+	}
+	recordWorkflowsWithMetricsMetrics(c.m, WorkflowsWithMetricsQuxMethod, WorkflowsWithMetricsClientSide, time.Since(start), err)
+	tracing.End(span, err)
+	return run, err
+}
+
+// Qux workflow.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *workflowsWithMetricsTemporalClient) ExecuteWorkflowWorkflowsWithMetricsQux(ctx context.Context, in *QuxInput) (*QuxOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "workflows-task-queue",
+	}
+	ctx, span := workflowsWithMetricsQuxTracing.Start(ctx, trace.SpanKindClient)
+	start := time.Now()
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Qux, in)
+	if err != nil {
+		recordWorkflowsWithMetricsMetrics(c.m, WorkflowsWithMetricsQuxMethod, WorkflowsWithMetricsClientSide, time.Since(start), err)
+		tracing.End(span, err)
+		return nil, err
+	}
+	tracing.SetWorkflowID(span, run.GetID())
+	var out *QuxOutput
+	err = run.Get(ctx, &out)
+	recordWorkflowsWithMetricsMetrics(c.m, WorkflowsWithMetricsQuxMethod, WorkflowsWithMetricsClientSide, time.Since(start), err)
+	tracing.End(span, err)
+	return out, err
+}
+
+// Qux workflow.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *workflowsWithMetricsTemporalClient) StartChildWorkflowWorkflowsWithMetricsQux(ctx workflow.Context, in *QuxInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "workflows-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Qux, in)
+}
+
+// Qux workflow.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *workflowsWithMetricsTemporalClient) ExecuteChildWorkflowWorkflowsWithMetricsQux(ctx workflow.Context, in *QuxInput) (*QuxOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "workflows-task-queue",
+	})
+	start := workflow.Now(ctx)
+	var out *QuxOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Qux, in).Get(ctx, &out)
+	recordWorkflowsWithMetricsMetrics(workflow.GetMetricsHandler(ctx), WorkflowsWithMetricsQuxMethod, WorkflowsWithMetricsClientSide, workflow.Now(ctx).Sub(start), err)
+	return out, err
+}
+
+// ContinueAsNewWorkflowsWithMetricsQux returns an error which ends the current run of the Qux
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewWorkflowsWithMetricsQux(ctx workflow.Context, in *QuxInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "workflows-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Qux", in)
+}
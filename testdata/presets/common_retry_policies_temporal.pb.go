@@ -0,0 +1,47 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: common_retry_policies.proto
+
+package common
+
+import (
+	temporal "go.temporal.io/sdk/temporal"
+	time "time"
+)
+
+// RetryPolicyTransient is the retry policy preset "transient" of file common_retry_policies.proto.
+var RetryPolicyTransient = &temporal.RetryPolicy{
+	InitialInterval:    time.Duration(1 * float64(time.Second)),
+	BackoffCoefficient: 2,
+	MaximumAttempts:    5,
+}
+
+// RetryPolicyNoRetries is the retry policy preset "no_retries" of file common_retry_policies.proto.
+var RetryPolicyNoRetries = &temporal.RetryPolicy{
+	MaximumAttempts: 1,
+}
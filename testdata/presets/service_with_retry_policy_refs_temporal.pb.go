@@ -0,0 +1,577 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_retry_policy_refs.proto
+
+package presets
+
+import (
+	context "context"
+	errors "errors"
+	common "github.com/daabr/protoc-gen-temporal-go/testdata/presets/common"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	temporal "go.temporal.io/sdk/temporal"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+	time "time"
+)
+
+// RetryPolicyPatient is the retry policy preset "patient" of file service_with_retry_policy_refs.proto.
+var RetryPolicyPatient = &temporal.RetryPolicy{
+	MaximumInterval: time.Duration(3600 * float64(time.Second)),
+}
+
+// ServiceWithRetryPolicyRefsRetryPolicyPatient is the retry policy preset "patient" of service ServiceWithRetryPolicyRefs.
+var ServiceWithRetryPolicyRefsRetryPolicyPatient = &temporal.RetryPolicy{
+	MaximumAttempts: 100,
+}
+
+// ServiceWithRetryPolicyRefsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithRetryPolicyRefsWorkerOption func(*worker.Options)
+
+// WithServiceWithRetryPolicyRefsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithRetryPolicyRefsBackgroundActivityContext(ctx context.Context) ServiceWithRetryPolicyRefsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithRetryPolicyRefsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithRetryPolicyRefsInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithRetryPolicyRefsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithRetryPolicyRefsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithRetryPolicyRefsOnFatalError(f func(error)) ServiceWithRetryPolicyRefsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithRetryPolicyRefsTaskQueue is the name of the task queue of the ServiceWithRetryPolicyRefs worker.
+const ServiceWithRetryPolicyRefsTaskQueue = "my-task-queue"
+
+// NewWorkerServiceWithRetryPolicyRefs creates a worker for the task queue of ServiceWithRetryPolicyRefs,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithRetryPolicyRefs.
+func NewWorkerServiceWithRetryPolicyRefs(c client.Client, runtimeOpts ...ServiceWithRetryPolicyRefsWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithRetryPolicyRefsTaskQueue, opts)
+}
+
+// RegisterServiceWithRetryPolicyRefs registers the workflows and activities of ServiceWithRetryPolicyRefs
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithRetryPolicyRefs(w worker.Registry, impl ServiceWithRetryPolicyRefsTemporalClient) {
+	w.RegisterActivity(impl.ImportedPreset)
+	w.RegisterActivity(impl.ServicePreset)
+	w.RegisterActivity(impl.InlinePolicy)
+	w.RegisterWorkflow(impl.ImportedPresetWorkflow)
+	w.RegisterActivity(impl.PresetWithErrors)
+	w.RegisterWorkflow(impl.WorkflowWithErrors)
+}
+
+// StartWorkerServiceWithRetryPolicyRefs runs a worker which hosts only ServiceWithRetryPolicyRefs,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithRetryPolicyRefs(c client.Client, impl ServiceWithRetryPolicyRefsTemporalClient, runtimeOpts ...ServiceWithRetryPolicyRefsWorkerOption) {
+	w := NewWorkerServiceWithRetryPolicyRefs(c, runtimeOpts...)
+	RegisterServiceWithRetryPolicyRefs(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+type ServiceWithRetryPolicyRefsTemporalClient interface {
+	// ImportedPreset activity, with a reference in the file's defaults.
+	ImportedPreset(ctx context.Context, in *FooInput) (*FooOutput, error)
+	// ServicePreset activity, which shadows the file's preset.
+	ServicePreset(ctx context.Context, in *FooInput) (*FooOutput, error)
+	// InlinePolicy activity, which overrides the reference in the defaults.
+	InlinePolicy(ctx context.Context, in *FooInput) (*FooOutput, error)
+	// ImportedPresetWorkflow workflow.
+	ImportedPresetWorkflow(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+	// PresetWithErrors activity, whose preset doesn't retry its errors.
+	PresetWithErrors(ctx context.Context, in *FooInput) (*FooOutput, error)
+	// WorkflowWithErrors workflow, without a retry policy.
+	WorkflowWithErrors(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+}
+
+type serviceWithRetryPolicyRefsTemporalClient struct {
+	t client.Client
+}
+
+func NewServiceWithRetryPolicyRefsTemporalClient(c client.Client) *ServiceWithRetryPolicyRefsTemporalClient {
+	return newServiceWithRetryPolicyRefsTemporalClient(c)
+}
+
+func newServiceWithRetryPolicyRefsTemporalClient(c client.Client) *serviceWithRetryPolicyRefsTemporalClient {
+	return &serviceWithRetryPolicyRefsTemporalClient{c}
+}
+
+// ImportedPreset activity, with a reference in the file's defaults.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithRetryPolicyRefsTemporalClient) StartActivityServiceWithRetryPolicyRefsImportedPreset(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy:         common.RetryPolicyTransient,
+	})
+	return workflow.ExecuteActivity(ctx, c.ImportedPreset, in)
+}
+
+// ImportedPreset activity, with a reference in the file's defaults.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithRetryPolicyRefsTemporalClient) ExecuteActivityServiceWithRetryPolicyRefsImportedPreset(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy:         common.RetryPolicyTransient,
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.ImportedPreset, in).Get(ctx, &out)
+	return out, err
+}
+
+// ImportedPreset activity, with a reference in the file's defaults.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithRetryPolicyRefsTemporalClient) StartLocalActivityServiceWithRetryPolicyRefsImportedPreset(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy:         common.RetryPolicyTransient,
+	})
+	return workflow.ExecuteActivity(ctx, c.ImportedPreset, in)
+}
+
+// ImportedPreset activity, with a reference in the file's defaults.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithRetryPolicyRefsTemporalClient) ExecuteLocalActivityServiceWithRetryPolicyRefsImportedPreset(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy:         common.RetryPolicyTransient,
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.ImportedPreset, in).Get(ctx, &out)
+	return out, err
+}
+
+// ServicePreset activity, which shadows the file's preset.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithRetryPolicyRefsTemporalClient) StartActivityServiceWithRetryPolicyRefsServicePreset(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy:         ServiceWithRetryPolicyRefsRetryPolicyPatient,
+	})
+	return workflow.ExecuteActivity(ctx, c.ServicePreset, in)
+}
+
+// ServicePreset activity, which shadows the file's preset.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithRetryPolicyRefsTemporalClient) ExecuteActivityServiceWithRetryPolicyRefsServicePreset(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy:         ServiceWithRetryPolicyRefsRetryPolicyPatient,
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.ServicePreset, in).Get(ctx, &out)
+	return out, err
+}
+
+// ServicePreset activity, which shadows the file's preset.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithRetryPolicyRefsTemporalClient) StartLocalActivityServiceWithRetryPolicyRefsServicePreset(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy:         ServiceWithRetryPolicyRefsRetryPolicyPatient,
+	})
+	return workflow.ExecuteActivity(ctx, c.ServicePreset, in)
+}
+
+// ServicePreset activity, which shadows the file's preset.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithRetryPolicyRefsTemporalClient) ExecuteLocalActivityServiceWithRetryPolicyRefsServicePreset(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy:         ServiceWithRetryPolicyRefsRetryPolicyPatient,
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.ServicePreset, in).Get(ctx, &out)
+	return out, err
+}
+
+// InlinePolicy activity, which overrides the reference in the defaults.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithRetryPolicyRefsTemporalClient) StartActivityServiceWithRetryPolicyRefsInlinePolicy(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+	})
+	return workflow.ExecuteActivity(ctx, c.InlinePolicy, in)
+}
+
+// InlinePolicy activity, which overrides the reference in the defaults.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithRetryPolicyRefsTemporalClient) ExecuteActivityServiceWithRetryPolicyRefsInlinePolicy(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.InlinePolicy, in).Get(ctx, &out)
+	return out, err
+}
+
+// InlinePolicy activity, which overrides the reference in the defaults.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithRetryPolicyRefsTemporalClient) StartLocalActivityServiceWithRetryPolicyRefsInlinePolicy(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+	})
+	return workflow.ExecuteActivity(ctx, c.InlinePolicy, in)
+}
+
+// InlinePolicy activity, which overrides the reference in the defaults.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithRetryPolicyRefsTemporalClient) ExecuteLocalActivityServiceWithRetryPolicyRefsInlinePolicy(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.InlinePolicy, in).Get(ctx, &out)
+	return out, err
+}
+
+// ImportedPresetWorkflow workflow.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithRetryPolicyRefsTemporalClient) StartWorkflowServiceWithRetryPolicyRefsImportedPresetWorkflow(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue:   "my-task-queue",
+		RetryPolicy: common.RetryPolicyNoRetries,
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.ImportedPresetWorkflow, in)
+}
+
+// ImportedPresetWorkflow workflow.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithRetryPolicyRefsTemporalClient) ExecuteWorkflowServiceWithRetryPolicyRefsImportedPresetWorkflow(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue:   "my-task-queue",
+		RetryPolicy: common.RetryPolicyNoRetries,
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.ImportedPresetWorkflow, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// ImportedPresetWorkflow workflow.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithRetryPolicyRefsTemporalClient) StartChildWorkflowServiceWithRetryPolicyRefsImportedPresetWorkflow(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue:   "my-task-queue",
+		RetryPolicy: common.RetryPolicyNoRetries,
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.ImportedPresetWorkflow, in)
+}
+
+// ImportedPresetWorkflow workflow.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithRetryPolicyRefsTemporalClient) ExecuteChildWorkflowServiceWithRetryPolicyRefsImportedPresetWorkflow(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue:   "my-task-queue",
+		RetryPolicy: common.RetryPolicyNoRetries,
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.ImportedPresetWorkflow, in).Get(ctx, &out)
+	return out, err
+}
+
+// PresetWithErrors activity, whose preset doesn't retry its errors.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithRetryPolicyRefsTemporalClient) StartActivityServiceWithRetryPolicyRefsPresetWithErrors(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 100,
+			NonRetryableErrorTypes: []string{
+				"OutOfStock",
+			},
+		},
+	})
+	return workflow.ExecuteActivity(ctx, c.PresetWithErrors, in)
+}
+
+// PresetWithErrors activity, whose preset doesn't retry its errors.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithRetryPolicyRefsTemporalClient) ExecuteActivityServiceWithRetryPolicyRefsPresetWithErrors(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 100,
+			NonRetryableErrorTypes: []string{
+				"OutOfStock",
+			},
+		},
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.PresetWithErrors, in).Get(ctx, &out)
+	return out, err
+}
+
+// PresetWithErrors activity, whose preset doesn't retry its errors.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithRetryPolicyRefsTemporalClient) StartLocalActivityServiceWithRetryPolicyRefsPresetWithErrors(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 100,
+			NonRetryableErrorTypes: []string{
+				"OutOfStock",
+			},
+		},
+	})
+	return workflow.ExecuteActivity(ctx, c.PresetWithErrors, in)
+}
+
+// PresetWithErrors activity, whose preset doesn't retry its errors.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithRetryPolicyRefsTemporalClient) ExecuteLocalActivityServiceWithRetryPolicyRefsPresetWithErrors(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 100,
+			NonRetryableErrorTypes: []string{
+				"OutOfStock",
+			},
+		},
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.PresetWithErrors, in).Get(ctx, &out)
+	return out, err
+}
+
+// WorkflowWithErrors workflow, without a retry policy.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithRetryPolicyRefsTemporalClient) StartWorkflowServiceWithRetryPolicyRefsWorkflowWithErrors(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.WorkflowWithErrors, in)
+}
+
+// WorkflowWithErrors workflow, without a retry policy.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithRetryPolicyRefsTemporalClient) ExecuteWorkflowServiceWithRetryPolicyRefsWorkflowWithErrors(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.WorkflowWithErrors, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// WorkflowWithErrors workflow, without a retry policy.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithRetryPolicyRefsTemporalClient) StartChildWorkflowServiceWithRetryPolicyRefsWorkflowWithErrors(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.WorkflowWithErrors, in)
+}
+
+// WorkflowWithErrors workflow, without a retry policy.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithRetryPolicyRefsTemporalClient) ExecuteChildWorkflowServiceWithRetryPolicyRefsWorkflowWithErrors(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.WorkflowWithErrors, in).Get(ctx, &out)
+	return out, err
+}
+
+// ServiceWithRetryPolicyRefsOutOfStockErrorType is the type of ServiceWithRetryPolicyRefs application errors with
+// *FooOutput details.
+const ServiceWithRetryPolicyRefsOutOfStockErrorType = "OutOfStock"
+
+// NewServiceWithRetryPolicyRefsOutOfStockError returns an application error with the given details,
+// to return from workflows and activities. Its type is ServiceWithRetryPolicyRefsOutOfStockErrorType.
+// Methods which declare this error type don't retry it.
+func NewServiceWithRetryPolicyRefsOutOfStockError(message string, cause error, details *FooOutput) error {
+	return temporal.NewApplicationErrorWithCause(message, ServiceWithRetryPolicyRefsOutOfStockErrorType, cause, details)
+}
+
+// AsServiceWithRetryPolicyRefsOutOfStock reports whether the given error (or any error in its
+// chain) is an application error whose type is ServiceWithRetryPolicyRefsOutOfStockErrorType,
+// and if so returns its details.
+func AsServiceWithRetryPolicyRefsOutOfStock(err error) (*FooOutput, bool) {
+	var appErr *temporal.ApplicationError
+	if !errors.As(err, &appErr) || appErr.Type() != ServiceWithRetryPolicyRefsOutOfStockErrorType || !appErr.HasDetails() {
+		return nil, false
+	}
+	var details *FooOutput
+	if err := appErr.Details(&details); err != nil {
+		return nil, false
+	}
+	return details, true
+}
+
+// ContinueAsNewServiceWithRetryPolicyRefsImportedPresetWorkflow returns an error which ends the current run of the ImportedPresetWorkflow
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithRetryPolicyRefsImportedPresetWorkflow(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "ImportedPresetWorkflow", in)
+}
+
+// ContinueAsNewServiceWithRetryPolicyRefsWorkflowWithErrors returns an error which ends the current run of the WorkflowWithErrors
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithRetryPolicyRefsWorkflowWithErrors(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "WorkflowWithErrors", in)
+}
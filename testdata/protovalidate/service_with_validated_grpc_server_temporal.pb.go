@@ -0,0 +1,342 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_validated_grpc_server.proto
+
+package protovalidate
+
+import (
+	context "context"
+	errors "errors"
+	protovalidate_go "github.com/bufbuild/protovalidate-go"
+	serviceerror "go.temporal.io/api/serviceerror"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	temporal "go.temporal.io/sdk/temporal"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	metadata "google.golang.org/grpc/metadata"
+	status "google.golang.org/grpc/status"
+	proto "google.golang.org/protobuf/proto"
+	log "log"
+	sync "sync"
+)
+
+// ServiceWithValidatedGrpcServerWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithValidatedGrpcServerWorkerOption func(*worker.Options)
+
+// WithServiceWithValidatedGrpcServerBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithValidatedGrpcServerBackgroundActivityContext(ctx context.Context) ServiceWithValidatedGrpcServerWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithValidatedGrpcServerInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithValidatedGrpcServerInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithValidatedGrpcServerWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithValidatedGrpcServerOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithValidatedGrpcServerOnFatalError(f func(error)) ServiceWithValidatedGrpcServerWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithValidatedGrpcServerTaskQueue is the name of the task queue of the ServiceWithValidatedGrpcServer worker.
+const ServiceWithValidatedGrpcServerTaskQueue = "my-grpc-task-queue"
+
+// NewWorkerServiceWithValidatedGrpcServer creates a worker for the task queue of ServiceWithValidatedGrpcServer,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithValidatedGrpcServer.
+func NewWorkerServiceWithValidatedGrpcServer(c client.Client, runtimeOpts ...ServiceWithValidatedGrpcServerWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithValidatedGrpcServerTaskQueue, opts)
+}
+
+// RegisterServiceWithValidatedGrpcServer registers the workflows and activities of ServiceWithValidatedGrpcServer
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithValidatedGrpcServer(w worker.Registry, impl ServiceWithValidatedGrpcServerTemporalClient) {
+	w.RegisterWorkflowWithOptions(func(ctx workflow.Context, in *QuxInput) (*QuxOutput, error) {
+		if err := validateServiceWithValidatedGrpcServerInput(in); err != nil {
+			return nil, err
+		}
+		return impl.Qux(ctx, in)
+	}, workflow.RegisterOptions{Name: "Qux"})
+}
+
+// StartWorkerServiceWithValidatedGrpcServer runs a worker which hosts only ServiceWithValidatedGrpcServer,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithValidatedGrpcServer(c client.Client, impl ServiceWithValidatedGrpcServerTemporalClient, runtimeOpts ...ServiceWithValidatedGrpcServerWorkerOption) {
+	w := NewWorkerServiceWithValidatedGrpcServer(c, runtimeOpts...)
+	RegisterServiceWithValidatedGrpcServer(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// ServiceWithValidatedGrpcServerValidationErrorType is the type of the non-retryable application errors which
+// the generated functions of ServiceWithValidatedGrpcServer return for invalid inputs.
+const ServiceWithValidatedGrpcServerValidationErrorType = "ValidationError"
+
+var validateServiceWithValidatedGrpcServerInputOnce sync.Once
+var validateServiceWithValidatedGrpcServerInputValidator *protovalidate_go.Validator
+var validateServiceWithValidatedGrpcServerInputErr error
+
+// validateServiceWithValidatedGrpcServerInput validates an input of ServiceWithValidatedGrpcServer with its protovalidate
+// constraints, and returns a non-retryable application error if it's invalid.
+func validateServiceWithValidatedGrpcServerInput(in proto.Message) error {
+	validateServiceWithValidatedGrpcServerInputOnce.Do(func() {
+		validateServiceWithValidatedGrpcServerInputValidator, validateServiceWithValidatedGrpcServerInputErr = protovalidate_go.New()
+	})
+	if validateServiceWithValidatedGrpcServerInputErr != nil {
+		return validateServiceWithValidatedGrpcServerInputErr
+	}
+	if err := validateServiceWithValidatedGrpcServerInputValidator.Validate(in); err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), ServiceWithValidatedGrpcServerValidationErrorType, err)
+	}
+	return nil
+}
+
+// serviceWithValidatedGrpcServerFailedChildWorkflowFuture is a child workflow future which has already failed,
+// because the input of the child workflow is invalid. The child workflow
+// doesn't start, so signals fail too.
+type serviceWithValidatedGrpcServerFailedChildWorkflowFuture struct {
+	workflow.Future
+}
+
+func (f serviceWithValidatedGrpcServerFailedChildWorkflowFuture) GetChildWorkflowExecution() workflow.Future {
+	return f.Future
+}
+
+func (f serviceWithValidatedGrpcServerFailedChildWorkflowFuture) SignalChildWorkflow(workflow.Context, string, interface{}) workflow.Future {
+	return f.Future
+}
+
+// ServiceWithValidatedGrpcServer is served over gRPC, and invalid inputs are
+// InvalidArgument errors.
+type ServiceWithValidatedGrpcServerTemporalClient interface {
+	// Qux workflow, whose input has constraints.
+	Qux(ctx workflow.Context, in *QuxInput) (*QuxOutput, error)
+}
+
+type serviceWithValidatedGrpcServerTemporalClient struct {
+	t client.Client
+}
+
+// ServiceWithValidatedGrpcServer is served over gRPC, and invalid inputs are
+// InvalidArgument errors.
+func NewServiceWithValidatedGrpcServerTemporalClient(c client.Client) *ServiceWithValidatedGrpcServerTemporalClient {
+	return newServiceWithValidatedGrpcServerTemporalClient(c)
+}
+
+func newServiceWithValidatedGrpcServerTemporalClient(c client.Client) *serviceWithValidatedGrpcServerTemporalClient {
+	return &serviceWithValidatedGrpcServerTemporalClient{c}
+}
+
+// Qux workflow, whose input has constraints.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithValidatedGrpcServerTemporalClient) StartWorkflowServiceWithValidatedGrpcServerQux(ctx context.Context, in *QuxInput) (client.WorkflowRun, error) {
+	if err := validateServiceWithValidatedGrpcServerInput(in); err != nil {
+		return nil, err
+	}
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-grpc-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Qux, in)
+}
+
+// Qux workflow, whose input has constraints.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithValidatedGrpcServerTemporalClient) ExecuteWorkflowServiceWithValidatedGrpcServerQux(ctx context.Context, in *QuxInput) (*QuxOutput, error) {
+	if err := validateServiceWithValidatedGrpcServerInput(in); err != nil {
+		return nil, err
+	}
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-grpc-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Qux, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *QuxOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Qux workflow, whose input has constraints.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithValidatedGrpcServerTemporalClient) StartChildWorkflowServiceWithValidatedGrpcServerQux(ctx workflow.Context, in *QuxInput) workflow.ChildWorkflowFuture {
+	if err := validateServiceWithValidatedGrpcServerInput(in); err != nil {
+		f, s := workflow.NewFuture(ctx)
+		s.SetError(err)
+		return serviceWithValidatedGrpcServerFailedChildWorkflowFuture{f}
+	}
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-grpc-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Qux, in)
+}
+
+// Qux workflow, whose input has constraints.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithValidatedGrpcServerTemporalClient) ExecuteChildWorkflowServiceWithValidatedGrpcServerQux(ctx workflow.Context, in *QuxInput) (*QuxOutput, error) {
+	if err := validateServiceWithValidatedGrpcServerInput(in); err != nil {
+		return nil, err
+	}
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-grpc-task-queue",
+	})
+	var out *QuxOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Qux, in).Get(ctx, &out)
+	return out, err
+}
+
+// ContinueAsNewServiceWithValidatedGrpcServerQux returns an error which ends the current run of the Qux
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithValidatedGrpcServerQux(ctx workflow.Context, in *QuxInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-grpc-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Qux", in)
+}
+
+const (
+	// ServiceWithValidatedGrpcServerWorkflowIDHeader is the gRPC response header with the ID of the workflow
+	// which a ServiceWithValidatedGrpcServer rpc starts, see WithServiceWithValidatedGrpcServerGRPCStartWorkflows.
+	ServiceWithValidatedGrpcServerWorkflowIDHeader = "temporal-workflow-id"
+	// ServiceWithValidatedGrpcServerRunIDHeader is the gRPC response header with the run ID of the workflow
+	// which a ServiceWithValidatedGrpcServer rpc starts, see WithServiceWithValidatedGrpcServerGRPCStartWorkflows.
+	ServiceWithValidatedGrpcServerRunIDHeader = "temporal-run-id"
+)
+
+type serviceWithValidatedGrpcServerGRPCServer struct {
+	UnimplementedServiceWithValidatedGrpcServerServer
+	c     *serviceWithValidatedGrpcServerTemporalClient
+	start bool
+}
+
+// ServiceWithValidatedGrpcServerGRPCServerOption configures the gRPC server of ServiceWithValidatedGrpcServer, see NewServiceWithValidatedGrpcServerGRPCServer.
+type ServiceWithValidatedGrpcServerGRPCServerOption func(*serviceWithValidatedGrpcServerGRPCServer)
+
+// WithServiceWithValidatedGrpcServerGRPCStartWorkflows makes workflow rpcs return as soon as their
+// workflows start, instead of when they complete. Their responses are empty
+// output messages (not the workflows' outputs, even if they complete at once),
+// and their headers contain the workflow and run IDs, see ServiceWithValidatedGrpcServerWorkflowIDHeader
+// and ServiceWithValidatedGrpcServerRunIDHeader. Clients can read them with the grpc.Header call option.
+func WithServiceWithValidatedGrpcServerGRPCStartWorkflows() ServiceWithValidatedGrpcServerGRPCServerOption {
+	return func(s *serviceWithValidatedGrpcServerGRPCServer) {
+		s.start = true
+	}
+}
+
+// NewServiceWithValidatedGrpcServerGRPCServer returns an implementation of ServiceWithValidatedGrpcServerServer (generated
+// by protoc-gen-go-grpc) which runs the workflow of each workflow rpc with the
+// given client, and by default waits for its completion. Temporal errors are
+// converted to gRPC status errors. Other rpcs are unimplemented.
+func NewServiceWithValidatedGrpcServerGRPCServer(c client.Client, opts ...ServiceWithValidatedGrpcServerGRPCServerOption) ServiceWithValidatedGrpcServerServer {
+	s := &serviceWithValidatedGrpcServerGRPCServer{c: newServiceWithValidatedGrpcServerTemporalClient(c)}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+// Qux runs the workflow protovalidate.ServiceWithValidatedGrpcServer.Qux, or only starts
+// it with WithServiceWithValidatedGrpcServerGRPCStartWorkflows and then returns an empty QuxOutput.
+func (s *serviceWithValidatedGrpcServerGRPCServer) Qux(ctx context.Context, in *QuxInput) (*QuxOutput, error) {
+	if s.start {
+		run, err := s.c.StartWorkflowServiceWithValidatedGrpcServerQux(ctx, in)
+		if err != nil {
+			return nil, serviceWithValidatedGrpcServerGRPCError(err)
+		}
+		// This fails only outside of gRPC servers, e.g. in unit tests.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(ServiceWithValidatedGrpcServerWorkflowIDHeader, run.GetID(), ServiceWithValidatedGrpcServerRunIDHeader, run.GetRunID()))
+		return &QuxOutput{}, nil
+	}
+	out, err := s.c.ExecuteWorkflowServiceWithValidatedGrpcServerQux(ctx, in)
+	if err != nil {
+		return nil, serviceWithValidatedGrpcServerGRPCError(err)
+	}
+	return out, nil
+}
+
+// serviceWithValidatedGrpcServerGRPCError converts an error of a workflow of ServiceWithValidatedGrpcServer to a gRPC
+// status error: workflows which already started are AlreadyExists, timeouts are
+// DeadlineExceeded, cancellations are Canceled, and terminations are Aborted.
+// Invalid inputs are InvalidArgument, and other application errors are Unknown.
+// Errors of the Temporal service keep their own codes.
+func serviceWithValidatedGrpcServerGRPCError(err error) error {
+	var timeoutErr *temporal.TimeoutError
+	var canceledErr *temporal.CanceledError
+	var terminatedErr *temporal.TerminatedError
+	var appErr *temporal.ApplicationError
+	code := serviceerror.ToStatus(err).Code()
+	switch {
+	case temporal.IsWorkflowExecutionAlreadyStartedError(err):
+		code = codes.AlreadyExists
+	case errors.As(err, &timeoutErr):
+		code = codes.DeadlineExceeded
+	case errors.As(err, &canceledErr):
+		code = codes.Canceled
+	case errors.As(err, &terminatedErr):
+		code = codes.Aborted
+	case errors.As(err, &appErr) && appErr.Type() == ServiceWithValidatedGrpcServerValidationErrorType:
+		code = codes.InvalidArgument
+	case errors.As(err, &appErr):
+		code = codes.Unknown
+	}
+	return status.Error(code, err.Error())
+}
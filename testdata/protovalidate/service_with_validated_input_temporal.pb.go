@@ -0,0 +1,381 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_validated_input.proto
+
+package protovalidate
+
+import (
+	context "context"
+	protovalidate_go "github.com/bufbuild/protovalidate-go"
+	activity "go.temporal.io/sdk/activity"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	temporal "go.temporal.io/sdk/temporal"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	proto "google.golang.org/protobuf/proto"
+	log "log"
+	sync "sync"
+	time "time"
+)
+
+// ServiceWithValidatedInputWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithValidatedInputWorkerOption func(*worker.Options)
+
+// WithServiceWithValidatedInputBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithValidatedInputBackgroundActivityContext(ctx context.Context) ServiceWithValidatedInputWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithValidatedInputInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithValidatedInputInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithValidatedInputWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithValidatedInputOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithValidatedInputOnFatalError(f func(error)) ServiceWithValidatedInputWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithValidatedInputTaskQueue is the name of the task queue of the ServiceWithValidatedInput worker.
+const ServiceWithValidatedInputTaskQueue = "my-task-queue"
+
+// NewWorkerServiceWithValidatedInput creates a worker for the task queue of ServiceWithValidatedInput,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithValidatedInput.
+func NewWorkerServiceWithValidatedInput(c client.Client, runtimeOpts ...ServiceWithValidatedInputWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithValidatedInputTaskQueue, opts)
+}
+
+// RegisterServiceWithValidatedInput registers the workflows and activities of ServiceWithValidatedInput
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithValidatedInput(w worker.Registry, impl ServiceWithValidatedInputTemporalClient) {
+	w.RegisterWorkflowWithOptions(func(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+		if err := validateServiceWithValidatedInputInput(in); err != nil {
+			return nil, err
+		}
+		return impl.Foo(ctx, in)
+	}, workflow.RegisterOptions{Name: "Foo"})
+	w.RegisterActivityWithOptions(func(ctx context.Context, in *BarInput) error {
+		if err := validateServiceWithValidatedInputInput(in); err != nil {
+			return err
+		}
+		return impl.Bar(ctx, in)
+	}, activity.RegisterOptions{Name: "Bar"})
+	w.RegisterActivity(impl.Baz)
+}
+
+// StartWorkerServiceWithValidatedInput runs a worker which hosts only ServiceWithValidatedInput,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithValidatedInput(c client.Client, impl ServiceWithValidatedInputTemporalClient, runtimeOpts ...ServiceWithValidatedInputWorkerOption) {
+	w := NewWorkerServiceWithValidatedInput(c, runtimeOpts...)
+	RegisterServiceWithValidatedInput(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// ServiceWithValidatedInputValidationErrorType is the type of the non-retryable application errors which
+// the generated functions of ServiceWithValidatedInput return for invalid inputs.
+const ServiceWithValidatedInputValidationErrorType = "ValidationError"
+
+var validateServiceWithValidatedInputInputOnce sync.Once
+var validateServiceWithValidatedInputInputValidator *protovalidate_go.Validator
+var validateServiceWithValidatedInputInputErr error
+
+// validateServiceWithValidatedInputInput validates an input of ServiceWithValidatedInput with its protovalidate
+// constraints, and returns a non-retryable application error if it's invalid.
+func validateServiceWithValidatedInputInput(in proto.Message) error {
+	validateServiceWithValidatedInputInputOnce.Do(func() {
+		validateServiceWithValidatedInputInputValidator, validateServiceWithValidatedInputInputErr = protovalidate_go.New()
+	})
+	if validateServiceWithValidatedInputInputErr != nil {
+		return validateServiceWithValidatedInputInputErr
+	}
+	if err := validateServiceWithValidatedInputInputValidator.Validate(in); err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), ServiceWithValidatedInputValidationErrorType, err)
+	}
+	return nil
+}
+
+// serviceWithValidatedInputFailedChildWorkflowFuture is a child workflow future which has already failed,
+// because the input of the child workflow is invalid. The child workflow
+// doesn't start, so signals fail too.
+type serviceWithValidatedInputFailedChildWorkflowFuture struct {
+	workflow.Future
+}
+
+func (f serviceWithValidatedInputFailedChildWorkflowFuture) GetChildWorkflowExecution() workflow.Future {
+	return f.Future
+}
+
+func (f serviceWithValidatedInputFailedChildWorkflowFuture) SignalChildWorkflow(workflow.Context, string, interface{}) workflow.Future {
+	return f.Future
+}
+
+type ServiceWithValidatedInputTemporalClient interface {
+	// Foo workflow, whose input has constraints in a nested message.
+	Foo(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+	// Bar activity, whose input has constraints.
+	Bar(ctx context.Context, in *BarInput) error
+	// Baz activity, whose input has no constraints.
+	Baz(ctx context.Context, in *BazInput) (*FooOutput, error)
+}
+
+type serviceWithValidatedInputTemporalClient struct {
+	t client.Client
+}
+
+func NewServiceWithValidatedInputTemporalClient(c client.Client) *ServiceWithValidatedInputTemporalClient {
+	return newServiceWithValidatedInputTemporalClient(c)
+}
+
+func newServiceWithValidatedInputTemporalClient(c client.Client) *serviceWithValidatedInputTemporalClient {
+	return &serviceWithValidatedInputTemporalClient{c}
+}
+
+// Foo workflow, whose input has constraints in a nested message.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithValidatedInputTemporalClient) StartWorkflowServiceWithValidatedInputFoo(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	if err := validateServiceWithValidatedInputInput(in); err != nil {
+		return nil, err
+	}
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+}
+
+// Foo workflow, whose input has constraints in a nested message.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithValidatedInputTemporalClient) ExecuteWorkflowServiceWithValidatedInputFoo(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	if err := validateServiceWithValidatedInputInput(in); err != nil {
+		return nil, err
+	}
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Foo workflow, whose input has constraints in a nested message.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithValidatedInputTemporalClient) StartChildWorkflowServiceWithValidatedInputFoo(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	if err := validateServiceWithValidatedInputInput(in); err != nil {
+		f, s := workflow.NewFuture(ctx)
+		s.SetError(err)
+		return serviceWithValidatedInputFailedChildWorkflowFuture{f}
+	}
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Foo, in)
+}
+
+// Foo workflow, whose input has constraints in a nested message.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithValidatedInputTemporalClient) ExecuteChildWorkflowServiceWithValidatedInputFoo(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	if err := validateServiceWithValidatedInputInput(in); err != nil {
+		return nil, err
+	}
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Foo, in).Get(ctx, &out)
+	return out, err
+}
+
+// Bar activity, whose input has constraints.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithValidatedInputTemporalClient) StartActivityServiceWithValidatedInputBar(ctx workflow.Context, in *BarInput) workflow.Future {
+	if err := validateServiceWithValidatedInputInput(in); err != nil {
+		f, s := workflow.NewFuture(ctx)
+		s.SetError(err)
+		return f
+	}
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity, whose input has constraints.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithValidatedInputTemporalClient) ExecuteActivityServiceWithValidatedInputBar(ctx workflow.Context, in *BarInput) error {
+	if err := validateServiceWithValidatedInputInput(in); err != nil {
+		return err
+	}
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in).Get(ctx, nil)
+}
+
+// Bar activity, whose input has constraints.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithValidatedInputTemporalClient) StartLocalActivityServiceWithValidatedInputBar(ctx workflow.Context, in *BarInput) workflow.Future {
+	if err := validateServiceWithValidatedInputInput(in); err != nil {
+		f, s := workflow.NewFuture(ctx)
+		s.SetError(err)
+		return f
+	}
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity, whose input has constraints.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithValidatedInputTemporalClient) ExecuteLocalActivityServiceWithValidatedInputBar(ctx workflow.Context, in *BarInput) error {
+	if err := validateServiceWithValidatedInputInput(in); err != nil {
+		return err
+	}
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteLocalActivity(ctx, c.Bar, in).Get(ctx, nil)
+}
+
+// Baz activity, whose input has no constraints.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithValidatedInputTemporalClient) StartActivityServiceWithValidatedInputBaz(ctx workflow.Context, in *BazInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Baz, in)
+}
+
+// Baz activity, whose input has no constraints.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithValidatedInputTemporalClient) ExecuteActivityServiceWithValidatedInputBaz(ctx workflow.Context, in *BazInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Baz, in).Get(ctx, &out)
+	return out, err
+}
+
+// Baz activity, whose input has no constraints.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithValidatedInputTemporalClient) StartLocalActivityServiceWithValidatedInputBaz(ctx workflow.Context, in *BazInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Baz, in)
+}
+
+// Baz activity, whose input has no constraints.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithValidatedInputTemporalClient) ExecuteLocalActivityServiceWithValidatedInputBaz(ctx workflow.Context, in *BazInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Baz, in).Get(ctx, &out)
+	return out, err
+}
+
+// ContinueAsNewServiceWithValidatedInputFoo returns an error which ends the current run of the Foo
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithValidatedInputFoo(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Foo", in)
+}
@@ -0,0 +1,242 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_sensitive_fields.proto
+
+package sensitive
+
+import (
+	context "context"
+	fmt "fmt"
+	sensitive "github.com/daabr/protoc-gen-temporal-go/sensitive"
+	client "go.temporal.io/sdk/client"
+	converter "go.temporal.io/sdk/converter"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	log "log"
+)
+
+// ServiceWithSensitiveFieldsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithSensitiveFieldsWorkerOption func(*worker.Options)
+
+// WithServiceWithSensitiveFieldsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithSensitiveFieldsBackgroundActivityContext(ctx context.Context) ServiceWithSensitiveFieldsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithSensitiveFieldsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithSensitiveFieldsInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithSensitiveFieldsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithSensitiveFieldsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithSensitiveFieldsOnFatalError(f func(error)) ServiceWithSensitiveFieldsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithSensitiveFieldsTaskQueue is the name of the task queue of the ServiceWithSensitiveFields worker.
+const ServiceWithSensitiveFieldsTaskQueue = "my-task-queue"
+
+// NewWorkerServiceWithSensitiveFields creates a worker for the task queue of ServiceWithSensitiveFields,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithSensitiveFields.
+func NewWorkerServiceWithSensitiveFields(c client.Client, runtimeOpts ...ServiceWithSensitiveFieldsWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithSensitiveFieldsTaskQueue, opts)
+}
+
+// RegisterServiceWithSensitiveFields registers the workflows and activities of ServiceWithSensitiveFields
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithSensitiveFields(w worker.Registry, impl ServiceWithSensitiveFieldsTemporalClient) {
+	w.RegisterWorkflow(impl.Onboard)
+}
+
+// StartWorkerServiceWithSensitiveFields runs a worker which hosts only ServiceWithSensitiveFields,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithSensitiveFields(c client.Client, impl ServiceWithSensitiveFieldsTemporalClient, runtimeOpts ...ServiceWithSensitiveFieldsWorkerOption) {
+	w := NewWorkerServiceWithSensitiveFields(c, runtimeOpts...)
+	RegisterServiceWithSensitiveFields(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// ServiceWithSensitiveFieldsDataConverter returns the data converter of ServiceWithSensitiveFields, which encodes
+// proto messages as binary (as specified in its proto definition), and other
+// values like the SDK's default data converter. It can still decode proto
+// messages in both encodings.
+func ServiceWithSensitiveFieldsDataConverter() converter.DataConverter {
+	return converter.NewCompositeDataConverter(
+		converter.NewNilPayloadConverter(),
+		converter.NewByteSlicePayloadConverter(),
+		converter.NewProtoPayloadConverter(),
+		converter.NewProtoJSONPayloadConverter(),
+		converter.NewJSONPayloadConverter(),
+	)
+}
+
+// CheckServiceWithSensitiveFieldsDataConverter returns an error if the given data converter doesn't encode
+// proto messages as binary, like ServiceWithSensitiveFieldsDataConverter.
+func CheckServiceWithSensitiveFieldsDataConverter(dc converter.DataConverter) error {
+	p, err := dc.ToPayload(&emptypb.Empty{})
+	if err != nil {
+		return fmt.Errorf("incompatible data converter for ServiceWithSensitiveFields: %w", err)
+	}
+	if e := string(p.Metadata[converter.MetadataEncoding]); e != converter.MetadataEncodingProto {
+		return fmt.Errorf("incompatible data converter for ServiceWithSensitiveFields: proto messages are encoded as %q instead of %q", e, converter.MetadataEncodingProto)
+	}
+	return nil
+}
+
+// ServiceWithSensitiveFieldsSensitiveDataConverter returns a data converter for ServiceWithSensitiveFields which encrypts
+// the sensitive fields of proto messages before they leave the client or worker,
+// and decrypts them on the way in, with keys from the given key provider.
+func ServiceWithSensitiveFieldsSensitiveDataConverter(kp sensitive.KeyProvider) converter.DataConverter {
+	return converter.NewCompositeDataConverter(
+		converter.NewNilPayloadConverter(),
+		converter.NewByteSlicePayloadConverter(),
+		sensitive.NewPayloadConverter(converter.NewProtoPayloadConverter(), kp),
+		sensitive.NewPayloadConverter(converter.NewProtoJSONPayloadConverter(), kp),
+		converter.NewJSONPayloadConverter(),
+	)
+}
+
+// DialServiceWithSensitiveFields creates a client with the data converter of ServiceWithSensitiveFields if the
+// given options don't specify a data converter: ServiceWithSensitiveFieldsSensitiveDataConverter(kp).
+// It returns an error if they specify a data converter with a different
+// encoding, instead of failing later in workflows and activities.
+// Use it instead of client.Dial to create the client which is passed to the
+// other generated functions of ServiceWithSensitiveFields.
+func DialServiceWithSensitiveFields(opts client.Options, kp sensitive.KeyProvider) (client.Client, error) {
+	if opts.DataConverter == nil {
+		opts.DataConverter = ServiceWithSensitiveFieldsSensitiveDataConverter(kp)
+	} else if err := CheckServiceWithSensitiveFieldsDataConverter(opts.DataConverter); err != nil {
+		return nil, err
+	}
+	return client.Dial(opts)
+}
+
+type ServiceWithSensitiveFieldsTemporalClient interface {
+	// Onboard workflow, whose input has sensitive fields in a nested message.
+	Onboard(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+}
+
+type serviceWithSensitiveFieldsTemporalClient struct {
+	t client.Client
+}
+
+func NewServiceWithSensitiveFieldsTemporalClient(c client.Client) *ServiceWithSensitiveFieldsTemporalClient {
+	return newServiceWithSensitiveFieldsTemporalClient(c)
+}
+
+func newServiceWithSensitiveFieldsTemporalClient(c client.Client) *serviceWithSensitiveFieldsTemporalClient {
+	return &serviceWithSensitiveFieldsTemporalClient{c}
+}
+
+// Onboard workflow, whose input has sensitive fields in a nested message.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithSensitiveFieldsTemporalClient) StartWorkflowServiceWithSensitiveFieldsOnboard(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Onboard, in)
+}
+
+// Onboard workflow, whose input has sensitive fields in a nested message.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithSensitiveFieldsTemporalClient) ExecuteWorkflowServiceWithSensitiveFieldsOnboard(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Onboard, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Onboard workflow, whose input has sensitive fields in a nested message.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithSensitiveFieldsTemporalClient) StartChildWorkflowServiceWithSensitiveFieldsOnboard(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Onboard, in)
+}
+
+// Onboard workflow, whose input has sensitive fields in a nested message.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithSensitiveFieldsTemporalClient) ExecuteChildWorkflowServiceWithSensitiveFieldsOnboard(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Onboard, in).Get(ctx, &out)
+	return out, err
+}
+
+// ContinueAsNewServiceWithSensitiveFieldsOnboard returns an error which ends the current run of the Onboard
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithSensitiveFieldsOnboard(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Onboard", in)
+}
@@ -0,0 +1,185 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: shared_task_queue_a.proto
+
+package shared
+
+import (
+	context "context"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+)
+
+// SharedTaskQueueAWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type SharedTaskQueueAWorkerOption func(*worker.Options)
+
+// WithSharedTaskQueueABackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithSharedTaskQueueABackgroundActivityContext(ctx context.Context) SharedTaskQueueAWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithSharedTaskQueueAInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithSharedTaskQueueAInterceptors(interceptors ...interceptor.WorkerInterceptor) SharedTaskQueueAWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithSharedTaskQueueAOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithSharedTaskQueueAOnFatalError(f func(error)) SharedTaskQueueAWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// SharedTaskQueueATaskQueue is the name of the task queue of the SharedTaskQueueA worker.
+const SharedTaskQueueATaskQueue = "shared-task-queue"
+
+// NewWorkerSharedTaskQueueA creates a worker for the task queue of SharedTaskQueueA,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterSharedTaskQueueA.
+func NewWorkerSharedTaskQueueA(c client.Client, runtimeOpts ...SharedTaskQueueAWorkerOption) worker.Worker {
+	opts := worker.Options{
+		Identity: "foo",
+	}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, SharedTaskQueueATaskQueue, opts)
+}
+
+// RegisterSharedTaskQueueA registers the workflows and activities of SharedTaskQueueA
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterSharedTaskQueueA(w worker.Registry, impl SharedTaskQueueATemporalClient) {
+	w.RegisterWorkflow(impl.Foo)
+}
+
+// StartWorkerSharedTaskQueueA runs a worker which hosts only SharedTaskQueueA,
+// until the process receives an interrupt signal.
+func StartWorkerSharedTaskQueueA(c client.Client, impl SharedTaskQueueATemporalClient, runtimeOpts ...SharedTaskQueueAWorkerOption) {
+	w := NewWorkerSharedTaskQueueA(c, runtimeOpts...)
+	RegisterSharedTaskQueueA(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+type SharedTaskQueueATemporalClient interface {
+	// Foo workflow.
+	Foo(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+}
+
+type sharedTaskQueueATemporalClient struct {
+	t client.Client
+}
+
+func NewSharedTaskQueueATemporalClient(c client.Client) *SharedTaskQueueATemporalClient {
+	return newSharedTaskQueueATemporalClient(c)
+}
+
+func newSharedTaskQueueATemporalClient(c client.Client) *sharedTaskQueueATemporalClient {
+	return &sharedTaskQueueATemporalClient{c}
+}
+
+// Foo workflow.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *sharedTaskQueueATemporalClient) StartWorkflowSharedTaskQueueAFoo(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "shared-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+}
+
+// Foo workflow.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *sharedTaskQueueATemporalClient) ExecuteWorkflowSharedTaskQueueAFoo(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "shared-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Foo workflow.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *sharedTaskQueueATemporalClient) StartChildWorkflowSharedTaskQueueAFoo(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "shared-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Foo, in)
+}
+
+// Foo workflow.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *sharedTaskQueueATemporalClient) ExecuteChildWorkflowSharedTaskQueueAFoo(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "shared-task-queue",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Foo, in).Get(ctx, &out)
+	return out, err
+}
+
+// ContinueAsNewSharedTaskQueueAFoo returns an error which ends the current run of the Foo
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewSharedTaskQueueAFoo(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "shared-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Foo", in)
+}
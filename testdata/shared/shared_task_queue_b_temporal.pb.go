@@ -0,0 +1,168 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: shared_task_queue_b.proto
+
+package shared
+
+import (
+	context "context"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+)
+
+// SharedTaskQueueBWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type SharedTaskQueueBWorkerOption func(*worker.Options)
+
+// WithSharedTaskQueueBBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithSharedTaskQueueBBackgroundActivityContext(ctx context.Context) SharedTaskQueueBWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithSharedTaskQueueBInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithSharedTaskQueueBInterceptors(interceptors ...interceptor.WorkerInterceptor) SharedTaskQueueBWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithSharedTaskQueueBOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithSharedTaskQueueBOnFatalError(f func(error)) SharedTaskQueueBWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// SharedTaskQueueBTaskQueue is the name of the task queue of the SharedTaskQueueB worker.
+const SharedTaskQueueBTaskQueue = "shared-task-queue"
+
+// NewWorkerSharedTaskQueueB creates a worker for the task queue of SharedTaskQueueB,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterSharedTaskQueueB.
+func NewWorkerSharedTaskQueueB(c client.Client, runtimeOpts ...SharedTaskQueueBWorkerOption) worker.Worker {
+	opts := worker.Options{
+		Identity: "foo",
+	}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, SharedTaskQueueBTaskQueue, opts)
+}
+
+// RegisterSharedTaskQueueB registers the workflows and activities of SharedTaskQueueB
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterSharedTaskQueueB(w worker.Registry, impl SharedTaskQueueBTemporalClient) {
+	w.RegisterActivity(impl.Bar)
+}
+
+// StartWorkerSharedTaskQueueB runs a worker which hosts only SharedTaskQueueB,
+// until the process receives an interrupt signal.
+func StartWorkerSharedTaskQueueB(c client.Client, impl SharedTaskQueueBTemporalClient, runtimeOpts ...SharedTaskQueueBWorkerOption) {
+	w := NewWorkerSharedTaskQueueB(c, runtimeOpts...)
+	RegisterSharedTaskQueueB(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+type SharedTaskQueueBTemporalClient interface {
+	// Bar activity.
+	Bar(ctx context.Context, in *FooInput) (*FooOutput, error)
+}
+
+type sharedTaskQueueBTemporalClient struct {
+	t client.Client
+}
+
+func NewSharedTaskQueueBTemporalClient(c client.Client) *SharedTaskQueueBTemporalClient {
+	return newSharedTaskQueueBTemporalClient(c)
+}
+
+func newSharedTaskQueueBTemporalClient(c client.Client) *sharedTaskQueueBTemporalClient {
+	return &sharedTaskQueueBTemporalClient{c}
+}
+
+// Bar activity.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *sharedTaskQueueBTemporalClient) StartActivitySharedTaskQueueBBar(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue: "shared-task-queue",
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *sharedTaskQueueBTemporalClient) ExecuteActivitySharedTaskQueueBBar(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue: "shared-task-queue",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Bar, in).Get(ctx, &out)
+	return out, err
+}
+
+// Bar activity.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *sharedTaskQueueBTemporalClient) StartLocalActivitySharedTaskQueueBBar(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *sharedTaskQueueBTemporalClient) ExecuteLocalActivitySharedTaskQueueBBar(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Bar, in).Get(ctx, &out)
+	return out, err
+}
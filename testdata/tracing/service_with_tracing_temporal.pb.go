@@ -0,0 +1,436 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: service_with_tracing.proto
+
+package tracing
+
+import (
+	context "context"
+	tracing "github.com/daabr/protoc-gen-temporal-go/tracing"
+	trace "go.opentelemetry.io/otel/trace"
+	activity "go.temporal.io/sdk/activity"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+	time "time"
+)
+
+// ServiceWithTracingWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type ServiceWithTracingWorkerOption func(*worker.Options)
+
+// WithServiceWithTracingBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithServiceWithTracingBackgroundActivityContext(ctx context.Context) ServiceWithTracingWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithServiceWithTracingInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithServiceWithTracingInterceptors(interceptors ...interceptor.WorkerInterceptor) ServiceWithTracingWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithServiceWithTracingOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithServiceWithTracingOnFatalError(f func(error)) ServiceWithTracingWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// ServiceWithTracingTaskQueue is the name of the task queue of the ServiceWithTracing worker.
+const ServiceWithTracingTaskQueue = "my-task-queue"
+
+// NewWorkerServiceWithTracing creates a worker for the task queue of ServiceWithTracing,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterServiceWithTracing.
+func NewWorkerServiceWithTracing(c client.Client, runtimeOpts ...ServiceWithTracingWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, ServiceWithTracingTaskQueue, opts)
+}
+
+// RegisterServiceWithTracing registers the workflows and activities of ServiceWithTracing
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterServiceWithTracing(w worker.Registry, impl ServiceWithTracingTemporalClient) {
+	w.RegisterWorkflow(impl.Foo)
+	w.RegisterWorkflow(impl.Notify)
+	w.RegisterActivityWithOptions(func(ctx context.Context, in *FooInput) (*FooOutput, error) {
+		ctx, span := serviceWithTracingBarTracing.Start(ctx, trace.SpanKindServer)
+		tracing.SetWorkflowID(span, activity.GetInfo(ctx).WorkflowExecution.ID)
+		out, err := impl.Bar(ctx, in)
+		tracing.End(span, err)
+		return out, err
+	}, activity.RegisterOptions{Name: "Bar"})
+	w.RegisterActivityWithOptions(func(ctx context.Context, in *FooInput) error {
+		ctx, span := serviceWithTracingBazTracing.Start(ctx, trace.SpanKindServer)
+		tracing.SetWorkflowID(span, activity.GetInfo(ctx).WorkflowExecution.ID)
+		err := impl.Baz(ctx, in)
+		tracing.End(span, err)
+		return err
+	}, activity.RegisterOptions{Name: "Baz"})
+}
+
+// StartWorkerServiceWithTracing runs a worker which hosts only ServiceWithTracing,
+// until the process receives an interrupt signal.
+func StartWorkerServiceWithTracing(c client.Client, impl ServiceWithTracingTemporalClient, runtimeOpts ...ServiceWithTracingWorkerOption) {
+	w := NewWorkerServiceWithTracing(c, runtimeOpts...)
+	RegisterServiceWithTracing(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// serviceWithTracingFooTracing describes the spans of Foo.
+var serviceWithTracingFooTracing = tracing.Method{
+	FullName:   "tracing.ServiceWithTracing.Foo",
+	Service:    "tracing.ServiceWithTracing",
+	TaskQueue:  "my-task-queue",
+	InputType:  "tracing.FooInput",
+	OutputType: "tracing.FooOutput",
+}
+
+// serviceWithTracingNotifyTracing describes the spans of Notify.
+var serviceWithTracingNotifyTracing = tracing.Method{
+	FullName:   "tracing.ServiceWithTracing.Notify",
+	Service:    "tracing.ServiceWithTracing",
+	TaskQueue:  "other-task-queue",
+	InputType:  "tracing.FooInput",
+	OutputType: "google.protobuf.Empty",
+}
+
+// serviceWithTracingBarTracing describes the spans of Bar.
+var serviceWithTracingBarTracing = tracing.Method{
+	FullName:   "tracing.ServiceWithTracing.Bar",
+	Service:    "tracing.ServiceWithTracing",
+	TaskQueue:  "my-task-queue",
+	InputType:  "tracing.FooInput",
+	OutputType: "tracing.FooOutput",
+}
+
+// serviceWithTracingBazTracing describes the spans of Baz.
+var serviceWithTracingBazTracing = tracing.Method{
+	FullName:   "tracing.ServiceWithTracing.Baz",
+	Service:    "tracing.ServiceWithTracing",
+	TaskQueue:  "my-task-queue",
+	InputType:  "tracing.FooInput",
+	OutputType: "google.protobuf.Empty",
+}
+
+type ServiceWithTracingTemporalClient interface {
+	// Foo workflow, which is traced in clients.
+	Foo(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+	// Notify workflow, which is traced in clients, and returns no output.
+	Notify(ctx workflow.Context, in *FooInput) error
+	// Bar activity, which is traced in workers.
+	Bar(ctx context.Context, in *FooInput) (*FooOutput, error)
+	// Baz activity, which is traced in workers, and returns no output.
+	Baz(ctx context.Context, in *FooInput) error
+}
+
+type serviceWithTracingTemporalClient struct {
+	t client.Client
+}
+
+func NewServiceWithTracingTemporalClient(c client.Client) *ServiceWithTracingTemporalClient {
+	return newServiceWithTracingTemporalClient(c)
+}
+
+func newServiceWithTracingTemporalClient(c client.Client) *serviceWithTracingTemporalClient {
+	return &serviceWithTracingTemporalClient{c}
+}
+
+// Foo workflow, which is traced in clients.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithTracingTemporalClient) StartWorkflowServiceWithTracingFoo(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	ctx, span := serviceWithTracingFooTracing.Start(ctx, trace.SpanKindClient)
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+	if err == nil {
+		tracing.SetWorkflowID(span, run.GetID())
+	}
+	tracing.End(span, err)
+	return run, err
+}
+
+// Foo workflow, which is traced in clients.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithTracingTemporalClient) ExecuteWorkflowServiceWithTracingFoo(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	ctx, span := serviceWithTracingFooTracing.Start(ctx, trace.SpanKindClient)
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+	if err != nil {
+		tracing.End(span, err)
+		return nil, err
+	}
+	tracing.SetWorkflowID(span, run.GetID())
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	tracing.End(span, err)
+	return out, err
+}
+
+// Foo workflow, which is traced in clients.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithTracingTemporalClient) StartChildWorkflowServiceWithTracingFoo(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Foo, in)
+}
+
+// Foo workflow, which is traced in clients.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithTracingTemporalClient) ExecuteChildWorkflowServiceWithTracingFoo(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Foo, in).Get(ctx, &out)
+	return out, err
+}
+
+// Notify workflow, which is traced in clients, and returns no output.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithTracingTemporalClient) StartWorkflowServiceWithTracingNotify(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "other-task-queue",
+	}
+	ctx, span := serviceWithTracingNotifyTracing.Start(ctx, trace.SpanKindClient)
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Notify, in)
+	if err == nil {
+		tracing.SetWorkflowID(span, run.GetID())
+	}
+	tracing.End(span, err)
+	return run, err
+}
+
+// Notify workflow, which is traced in clients, and returns no output.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *serviceWithTracingTemporalClient) ExecuteWorkflowServiceWithTracingNotify(ctx context.Context, in *FooInput) error {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "other-task-queue",
+	}
+	ctx, span := serviceWithTracingNotifyTracing.Start(ctx, trace.SpanKindClient)
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Notify, in)
+	if err != nil {
+		tracing.End(span, err)
+		return err
+	}
+	tracing.SetWorkflowID(span, run.GetID())
+	err = run.Get(ctx, nil)
+	tracing.End(span, err)
+	return err
+}
+
+// Notify workflow, which is traced in clients, and returns no output.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithTracingTemporalClient) StartChildWorkflowServiceWithTracingNotify(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "other-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Notify, in)
+}
+
+// Notify workflow, which is traced in clients, and returns no output.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *serviceWithTracingTemporalClient) ExecuteChildWorkflowServiceWithTracingNotify(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "other-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Notify, in).Get(ctx, nil)
+}
+
+// Bar activity, which is traced in workers.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithTracingTemporalClient) StartActivityServiceWithTracingBar(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity, which is traced in workers.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithTracingTemporalClient) ExecuteActivityServiceWithTracingBar(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Bar, in).Get(ctx, &out)
+	return out, err
+}
+
+// Bar activity, which is traced in workers.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithTracingTemporalClient) StartLocalActivityServiceWithTracingBar(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity, which is traced in workers.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithTracingTemporalClient) ExecuteLocalActivityServiceWithTracingBar(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Bar, in).Get(ctx, &out)
+	return out, err
+}
+
+// Baz activity, which is traced in workers, and returns no output.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithTracingTemporalClient) StartActivityServiceWithTracingBaz(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Baz, in)
+}
+
+// Baz activity, which is traced in workers, and returns no output.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *serviceWithTracingTemporalClient) ExecuteActivityServiceWithTracingBaz(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Baz, in).Get(ctx, nil)
+}
+
+// Baz activity, which is traced in workers, and returns no output.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithTracingTemporalClient) StartLocalActivityServiceWithTracingBaz(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Baz, in)
+}
+
+// Baz activity, which is traced in workers, and returns no output.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *serviceWithTracingTemporalClient) ExecuteLocalActivityServiceWithTracingBaz(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteLocalActivity(ctx, c.Baz, in).Get(ctx, nil)
+}
+
+// ContinueAsNewServiceWithTracingFoo returns an error which ends the current run of the Foo
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithTracingFoo(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Foo", in)
+}
+
+// ContinueAsNewServiceWithTracingNotify returns an error which ends the current run of the Notify
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewServiceWithTracingNotify(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "other-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Notify", in)
+}
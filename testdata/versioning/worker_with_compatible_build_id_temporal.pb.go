@@ -0,0 +1,265 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: worker_with_compatible_build_id.proto
+
+package versioning
+
+import (
+	context "context"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	temporal "go.temporal.io/sdk/temporal"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+	time "time"
+)
+
+// WorkerWithCompatibleBuildIdWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type WorkerWithCompatibleBuildIdWorkerOption func(*worker.Options)
+
+// WithWorkerWithCompatibleBuildIdBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithWorkerWithCompatibleBuildIdBackgroundActivityContext(ctx context.Context) WorkerWithCompatibleBuildIdWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithWorkerWithCompatibleBuildIdInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithWorkerWithCompatibleBuildIdInterceptors(interceptors ...interceptor.WorkerInterceptor) WorkerWithCompatibleBuildIdWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithWorkerWithCompatibleBuildIdOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithWorkerWithCompatibleBuildIdOnFatalError(f func(error)) WorkerWithCompatibleBuildIdWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// WorkerWithCompatibleBuildIdTaskQueue is the name of the task queue of the WorkerWithCompatibleBuildId worker.
+const WorkerWithCompatibleBuildIdTaskQueue = "my-task-queue"
+
+// NewWorkerWorkerWithCompatibleBuildId creates a worker for the task queue of WorkerWithCompatibleBuildId,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterWorkerWithCompatibleBuildId.
+func NewWorkerWorkerWithCompatibleBuildId(c client.Client, runtimeOpts ...WorkerWithCompatibleBuildIdWorkerOption) worker.Worker {
+	opts := worker.Options{
+		BuildID:                 "1.1.0",
+		UseBuildIDForVersioning: true,
+	}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, WorkerWithCompatibleBuildIdTaskQueue, opts)
+}
+
+// RegisterWorkerWithCompatibleBuildId registers the workflows and activities of WorkerWithCompatibleBuildId
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterWorkerWithCompatibleBuildId(w worker.Registry, impl WorkerWithCompatibleBuildIdTemporalClient) {
+	w.RegisterWorkflow(impl.Foo)
+	w.RegisterActivity(impl.Bar)
+}
+
+// StartWorkerWorkerWithCompatibleBuildId runs a worker which hosts only WorkerWithCompatibleBuildId,
+// until the process receives an interrupt signal.
+func StartWorkerWorkerWithCompatibleBuildId(c client.Client, impl WorkerWithCompatibleBuildIdTemporalClient, runtimeOpts ...WorkerWithCompatibleBuildIdWorkerOption) {
+	w := NewWorkerWorkerWithCompatibleBuildId(c, runtimeOpts...)
+	RegisterWorkerWithCompatibleBuildId(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+// UpdateBuildIDCompatibilityWorkerWithCompatibleBuildId registers the build ID of the WorkerWithCompatibleBuildId worker
+// ("1.1.0") as compatible with its predecessor ("1.0.0"),
+// in the task queue of the worker. Call it before starting workers with this build ID.
+// For more information, see https://docs.temporal.io/workers#worker-versioning.
+func UpdateBuildIDCompatibilityWorkerWithCompatibleBuildId(ctx context.Context, c client.Client) error {
+	return c.UpdateWorkerBuildIdCompatibility(ctx, &client.UpdateWorkerBuildIdCompatibilityOptions{
+		TaskQueue: WorkerWithCompatibleBuildIdTaskQueue,
+		Operation: &client.BuildIDOpAddNewCompatibleVersion{
+			BuildID:                   "1.1.0",
+			ExistingCompatibleBuildId: "1.0.0",
+		},
+	})
+}
+
+type WorkerWithCompatibleBuildIdTemporalClient interface {
+	// Foo workflow, whose children run on the default build ID.
+	Foo(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+	// Bar activity, which runs on a compatible build ID.
+	Bar(ctx context.Context, in *FooInput) (*FooOutput, error)
+}
+
+type workerWithCompatibleBuildIdTemporalClient struct {
+	t client.Client
+}
+
+func NewWorkerWithCompatibleBuildIdTemporalClient(c client.Client) *WorkerWithCompatibleBuildIdTemporalClient {
+	return newWorkerWithCompatibleBuildIdTemporalClient(c)
+}
+
+func newWorkerWithCompatibleBuildIdTemporalClient(c client.Client) *workerWithCompatibleBuildIdTemporalClient {
+	return &workerWithCompatibleBuildIdTemporalClient{c}
+}
+
+// Foo workflow, whose children run on the default build ID.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *workerWithCompatibleBuildIdTemporalClient) StartWorkflowWorkerWithCompatibleBuildIdFoo(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+}
+
+// Foo workflow, whose children run on the default build ID.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *workerWithCompatibleBuildIdTemporalClient) ExecuteWorkflowWorkerWithCompatibleBuildIdFoo(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Foo workflow, whose children run on the default build ID.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *workerWithCompatibleBuildIdTemporalClient) StartChildWorkflowWorkerWithCompatibleBuildIdFoo(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue:        "my-task-queue",
+		VersioningIntent: temporal.VersioningIntentDefault,
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Foo, in)
+}
+
+// Foo workflow, whose children run on the default build ID.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *workerWithCompatibleBuildIdTemporalClient) ExecuteChildWorkflowWorkerWithCompatibleBuildIdFoo(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue:        "my-task-queue",
+		VersioningIntent: temporal.VersioningIntentDefault,
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Foo, in).Get(ctx, &out)
+	return out, err
+}
+
+// Bar activity, which runs on a compatible build ID.
+//
+// This method starts the activity with pre-configured options, and returns a
+// Future to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *workerWithCompatibleBuildIdTemporalClient) StartActivityWorkerWithCompatibleBuildIdBar(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		VersioningIntent:    temporal.VersioningIntentCompatible,
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity, which runs on a compatible build ID.
+//
+// This method executes the activity with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#activity-execution.
+func (c *workerWithCompatibleBuildIdTemporalClient) ExecuteActivityWorkerWithCompatibleBuildIdBar(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		TaskQueue:           "my-task-queue",
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+		VersioningIntent:    temporal.VersioningIntentCompatible,
+	})
+	var out *FooOutput
+	err := workflow.ExecuteActivity(ctx, c.Bar, in).Get(ctx, &out)
+	return out, err
+}
+
+// Bar activity, which runs on a compatible build ID.
+//
+// This method starts the activity (locally) with pre-configured options, and
+// returns a Future to interact with it until completion. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *workerWithCompatibleBuildIdTemporalClient) StartLocalActivityWorkerWithCompatibleBuildIdBar(ctx workflow.Context, in *FooInput) workflow.Future {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	return workflow.ExecuteActivity(ctx, c.Bar, in)
+}
+
+// Bar activity, which runs on a compatible build ID.
+//
+// This method executes the activity (locally) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#activity-execution
+// and https://docs.temporal.io/activities#local-activity.
+func (c *workerWithCompatibleBuildIdTemporalClient) ExecuteLocalActivityWorkerWithCompatibleBuildIdBar(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithLocalActivityOptions(ctx, workflow.LocalActivityOptions{
+		StartToCloseTimeout: time.Duration(10 * float64(time.Second)),
+	})
+	var out *FooOutput
+	err := workflow.ExecuteLocalActivity(ctx, c.Bar, in).Get(ctx, &out)
+	return out, err
+}
+
+// ContinueAsNewWorkerWithCompatibleBuildIdFoo returns an error which ends the current run of the Foo
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewWorkerWithCompatibleBuildIdFoo(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Foo", in)
+}
@@ -0,0 +1,219 @@
+//
+//MIT License
+//
+//Copyright (c) 2023 Daniel Abraham
+//
+//Permission is hereby granted, free of charge, to any person obtaining a copy
+//of this software and associated documentation files (the "Software"), to deal
+//in the Software without restriction, including without limitation the rights
+//to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+//copies of the Software, and to permit persons to whom the Software is
+//furnished to do so, subject to the following conditions:
+//
+//The above copyright notice and this permission notice shall be included in all
+//copies or substantial portions of the Software.
+//
+//THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+//IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+//FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+//AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+//LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+//OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+//SOFTWARE.
+
+// Code generated by protoc-gen-temporal-go. DO NOT EDIT.
+// versions:
+// - protoc-gen-temporal-go v0.0.0
+// - protoc                 v4.23.2
+// source: workflow_with_version_changes.proto
+
+package versions
+
+import (
+	context "context"
+	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
+	worker "go.temporal.io/sdk/worker"
+	workflow "go.temporal.io/sdk/workflow"
+	log "log"
+)
+
+// WorkflowWithVersionChangesWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type WorkflowWithVersionChangesWorkerOption func(*worker.Options)
+
+// WithWorkflowWithVersionChangesBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithWorkflowWithVersionChangesBackgroundActivityContext(ctx context.Context) WorkflowWithVersionChangesWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithWorkflowWithVersionChangesInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithWorkflowWithVersionChangesInterceptors(interceptors ...interceptor.WorkerInterceptor) WorkflowWithVersionChangesWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithWorkflowWithVersionChangesOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithWorkflowWithVersionChangesOnFatalError(f func(error)) WorkflowWithVersionChangesWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// WorkflowWithVersionChangesTaskQueue is the name of the task queue of the WorkflowWithVersionChanges worker.
+const WorkflowWithVersionChangesTaskQueue = "my-task-queue"
+
+// NewWorkerWorkflowWithVersionChanges creates a worker for the task queue of WorkflowWithVersionChanges,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterWorkflowWithVersionChanges.
+func NewWorkerWorkflowWithVersionChanges(c client.Client, runtimeOpts ...WorkflowWithVersionChangesWorkerOption) worker.Worker {
+	opts := worker.Options{}
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, WorkflowWithVersionChangesTaskQueue, opts)
+}
+
+// RegisterWorkflowWithVersionChanges registers the workflows and activities of WorkflowWithVersionChanges
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterWorkflowWithVersionChanges(w worker.Registry, impl WorkflowWithVersionChangesTemporalClient) {
+	w.RegisterWorkflow(impl.Checkout)
+}
+
+// StartWorkerWorkflowWithVersionChanges runs a worker which hosts only WorkflowWithVersionChanges,
+// until the process receives an interrupt signal.
+func StartWorkerWorkflowWithVersionChanges(c client.Client, impl WorkflowWithVersionChangesTemporalClient, runtimeOpts ...WorkflowWithVersionChangesWorkerOption) {
+	w := NewWorkerWorkflowWithVersionChanges(c, runtimeOpts...)
+	RegisterWorkflowWithVersionChanges(w, impl)
+
+	if err := w.Run(worker.InterruptCh()); err != nil {
+		log.Fatalln("Failed to start Temporal worker:", err)
+	}
+}
+
+type WorkflowWithVersionChangesTemporalClient interface {
+	// Checkout workflow.
+	Checkout(ctx workflow.Context, in *FooInput) (*FooOutput, error)
+}
+
+type workflowWithVersionChangesTemporalClient struct {
+	t client.Client
+}
+
+func NewWorkflowWithVersionChangesTemporalClient(c client.Client) *WorkflowWithVersionChangesTemporalClient {
+	return newWorkflowWithVersionChangesTemporalClient(c)
+}
+
+func newWorkflowWithVersionChangesTemporalClient(c client.Client) *workflowWithVersionChangesTemporalClient {
+	return &workflowWithVersionChangesTemporalClient{c}
+}
+
+// Checkout workflow.
+//
+// This method starts the workflow with pre-configured options, and returns a
+// WorkflowRun to interact with it until completion. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *workflowWithVersionChangesTemporalClient) StartWorkflowWorkflowWithVersionChangesCheckout(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	return c.t.ExecuteWorkflow(ctx, opts, c.Checkout, in)
+}
+
+// Checkout workflow.
+//
+// This method executes the workflow with pre-configured options, blocks until
+// completion, and returns the output/error results. For more information, see
+// https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
+func (c *workflowWithVersionChangesTemporalClient) ExecuteWorkflowWorkflowWithVersionChangesCheckout(ctx context.Context, in *FooInput) (*FooOutput, error) {
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
+	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Checkout, in)
+	if err != nil {
+		return nil, err
+	}
+	var out *FooOutput
+	err = run.Get(ctx, &out)
+	return out, err
+}
+
+// Checkout workflow.
+//
+// This method starts the workflow (as a child) with pre-configured options,
+// and returns a Future to interact with it until completion. For more info,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *workflowWithVersionChangesTemporalClient) StartChildWorkflowWorkflowWithVersionChangesCheckout(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	return workflow.ExecuteChildWorkflow(ctx, c.Checkout, in)
+}
+
+// Checkout workflow.
+//
+// This method executes the workflow (as a child) with pre-configured options,
+// blocks until completion, and returns the output/error. For more information,
+// see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
+// and https://docs.temporal.io/workflows#child-workflow.
+func (c *workflowWithVersionChangesTemporalClient) ExecuteChildWorkflowWorkflowWithVersionChangesCheckout(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
+	var out *FooOutput
+	err := workflow.ExecuteChildWorkflow(ctx, c.Checkout, in).Get(ctx, &out)
+	return out, err
+}
+
+// ContinueAsNewWorkflowWithVersionChangesCheckout returns an error which ends the current run of the Checkout
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewWorkflowWithVersionChangesCheckout(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Checkout", in)
+}
+
+// WorkflowWithVersionChangesCheckoutChangeAddFraudCheck is the ID of the "add_fraud_check" change in
+// the Checkout workflow.
+//
+// Check for fraud before charging the customer.
+const WorkflowWithVersionChangesCheckoutChangeAddFraudCheck = "add_fraud_check"
+
+// Min and max supported versions of WorkflowWithVersionChangesCheckoutChangeAddFraudCheck.
+const (
+	WorkflowWithVersionChangesCheckoutVersionAddFraudCheckMin workflow.Version = workflow.DefaultVersion
+	WorkflowWithVersionChangesCheckoutVersionAddFraudCheckMax workflow.Version = 1
+)
+
+// WorkflowWithVersionChangesCheckoutVersionAddFraudCheck returns the version of the "add_fraud_check" change
+// which the current run of the Checkout workflow should execute. For
+// more information, see https://docs.temporal.io/dev-guide/go/versioning#workflow-versioning.
+func WorkflowWithVersionChangesCheckoutVersionAddFraudCheck(ctx workflow.Context) workflow.Version {
+	return workflow.GetVersion(ctx, WorkflowWithVersionChangesCheckoutChangeAddFraudCheck, WorkflowWithVersionChangesCheckoutVersionAddFraudCheckMin, WorkflowWithVersionChangesCheckoutVersionAddFraudCheckMax)
+}
+
+// WorkflowWithVersionChangesCheckoutChangeParallelShipping is the ID of the "parallel_shipping" change in
+// the Checkout workflow.
+const WorkflowWithVersionChangesCheckoutChangeParallelShipping = "parallel_shipping"
+
+// Min and max supported versions of WorkflowWithVersionChangesCheckoutChangeParallelShipping.
+const (
+	WorkflowWithVersionChangesCheckoutVersionParallelShippingMin workflow.Version = 1
+	WorkflowWithVersionChangesCheckoutVersionParallelShippingMax workflow.Version = 2
+)
+
+// WorkflowWithVersionChangesCheckoutVersionParallelShipping returns the version of the "parallel_shipping" change
+// which the current run of the Checkout workflow should execute. For
+// more information, see https://docs.temporal.io/dev-guide/go/versioning#workflow-versioning.
+func WorkflowWithVersionChangesCheckoutVersionParallelShipping(ctx workflow.Context) workflow.Version {
+	return workflow.GetVersion(ctx, WorkflowWithVersionChangesCheckoutChangeParallelShipping, WorkflowWithVersionChangesCheckoutVersionParallelShippingMin, WorkflowWithVersionChangesCheckoutVersionParallelShippingMax)
+}
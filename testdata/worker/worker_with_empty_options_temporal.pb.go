@@ -30,15 +30,66 @@
 package worker
 
 import (
+	context "context"
 	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
 	worker "go.temporal.io/sdk/worker"
 	log "log"
 )
 
-func StartWorkerWorkerWithEmptyOptions(c client.Client) {
-	taskQueue := "my-task-queue"
+// WorkerWithEmptyOptionsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type WorkerWithEmptyOptionsWorkerOption func(*worker.Options)
+
+// WithWorkerWithEmptyOptionsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithWorkerWithEmptyOptionsBackgroundActivityContext(ctx context.Context) WorkerWithEmptyOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithWorkerWithEmptyOptionsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithWorkerWithEmptyOptionsInterceptors(interceptors ...interceptor.WorkerInterceptor) WorkerWithEmptyOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithWorkerWithEmptyOptionsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithWorkerWithEmptyOptionsOnFatalError(f func(error)) WorkerWithEmptyOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// WorkerWithEmptyOptionsTaskQueue is the name of the task queue of the WorkerWithEmptyOptions worker.
+const WorkerWithEmptyOptionsTaskQueue = "my-task-queue"
+
+// NewWorkerWorkerWithEmptyOptions creates a worker for the task queue of WorkerWithEmptyOptions,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterWorkerWithEmptyOptions.
+func NewWorkerWorkerWithEmptyOptions(c client.Client, runtimeOpts ...WorkerWithEmptyOptionsWorkerOption) worker.Worker {
 	opts := worker.Options{}
-	w := worker.New(c, taskQueue, opts)
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, WorkerWithEmptyOptionsTaskQueue, opts)
+}
+
+// RegisterWorkerWithEmptyOptions registers the workflows and activities of WorkerWithEmptyOptions
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterWorkerWithEmptyOptions(w worker.Registry, impl WorkerWithEmptyOptionsTemporalClient) {
+}
+
+// StartWorkerWorkerWithEmptyOptions runs a worker which hosts only WorkerWithEmptyOptions,
+// until the process receives an interrupt signal.
+func StartWorkerWorkerWithEmptyOptions(c client.Client, impl WorkerWithEmptyOptionsTemporalClient, runtimeOpts ...WorkerWithEmptyOptionsWorkerOption) {
+	w := NewWorkerWorkerWithEmptyOptions(c, runtimeOpts...)
+	RegisterWorkerWithEmptyOptions(w, impl)
 
 	if err := w.Run(worker.InterruptCh()); err != nil {
 		log.Fatalln("Failed to start Temporal worker:", err)
@@ -53,5 +104,9 @@ type workerWithEmptyOptionsTemporalClient struct {
 }
 
 func NewWorkerWithEmptyOptionsTemporalClient(c client.Client) *WorkerWithEmptyOptionsTemporalClient {
+	return newWorkerWithEmptyOptionsTemporalClient(c)
+}
+
+func newWorkerWithEmptyOptionsTemporalClient(c client.Client) *workerWithEmptyOptionsTemporalClient {
 	return &workerWithEmptyOptionsTemporalClient{c}
 }
@@ -30,22 +30,74 @@
 package worker
 
 import (
+	context "context"
 	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
 	worker "go.temporal.io/sdk/worker"
 	log "log"
 	time "time"
 )
 
-func StartWorkerWorkerWithOptions(c client.Client) {
-	taskQueue := "my-task-queue"
+// WorkerWithOptionsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type WorkerWithOptionsWorkerOption func(*worker.Options)
+
+// WithWorkerWithOptionsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithWorkerWithOptionsBackgroundActivityContext(ctx context.Context) WorkerWithOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithWorkerWithOptionsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithWorkerWithOptionsInterceptors(interceptors ...interceptor.WorkerInterceptor) WorkerWithOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithWorkerWithOptionsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithWorkerWithOptionsOnFatalError(f func(error)) WorkerWithOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// WorkerWithOptionsTaskQueue is the name of the task queue of the WorkerWithOptions worker.
+const WorkerWithOptionsTaskQueue = "my-task-queue"
+
+// NewWorkerWorkerWithOptions creates a worker for the task queue of WorkerWithOptions,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterWorkerWithOptions.
+func NewWorkerWorkerWithOptions(c client.Client, runtimeOpts ...WorkerWithOptionsWorkerOption) worker.Worker {
 	opts := worker.Options{
 		MaxConcurrentActivityExecutionSize: 100,
 		WorkerActivitiesPerSecond:          0.1,
 		EnableLoggingInReplay:              true,
 		StickyScheduleToStartTimeout:       time.Duration(10.000000001 * float64(time.Second)),
+		WorkflowPanicPolicy:                worker.FailWorkflow,
 		Identity:                           "foo",
 	}
-	w := worker.New(c, taskQueue, opts)
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, WorkerWithOptionsTaskQueue, opts)
+}
+
+// RegisterWorkerWithOptions registers the workflows and activities of WorkerWithOptions
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterWorkerWithOptions(w worker.Registry, impl WorkerWithOptionsTemporalClient) {
+}
+
+// StartWorkerWorkerWithOptions runs a worker which hosts only WorkerWithOptions,
+// until the process receives an interrupt signal.
+func StartWorkerWorkerWithOptions(c client.Client, impl WorkerWithOptionsTemporalClient, runtimeOpts ...WorkerWithOptionsWorkerOption) {
+	w := NewWorkerWorkerWithOptions(c, runtimeOpts...)
+	RegisterWorkerWithOptions(w, impl)
 
 	if err := w.Run(worker.InterruptCh()); err != nil {
 		log.Fatalln("Failed to start Temporal worker:", err)
@@ -60,5 +112,9 @@ type workerWithOptionsTemporalClient struct {
 }
 
 func NewWorkerWithOptionsTemporalClient(c client.Client) *WorkerWithOptionsTemporalClient {
+	return newWorkerWithOptionsTemporalClient(c)
+}
+
+func newWorkerWithOptionsTemporalClient(c client.Client) *workerWithOptionsTemporalClient {
 	return &workerWithOptionsTemporalClient{c}
 }
@@ -30,15 +30,66 @@
 package worker
 
 import (
+	context "context"
 	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
 	worker "go.temporal.io/sdk/worker"
 	log "log"
 )
 
-func StartWorkerWorkerWithoutOptions(c client.Client) {
-	taskQueue := "my-task-queue"
+// WorkerWithoutOptionsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type WorkerWithoutOptionsWorkerOption func(*worker.Options)
+
+// WithWorkerWithoutOptionsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithWorkerWithoutOptionsBackgroundActivityContext(ctx context.Context) WorkerWithoutOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithWorkerWithoutOptionsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithWorkerWithoutOptionsInterceptors(interceptors ...interceptor.WorkerInterceptor) WorkerWithoutOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithWorkerWithoutOptionsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithWorkerWithoutOptionsOnFatalError(f func(error)) WorkerWithoutOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// WorkerWithoutOptionsTaskQueue is the name of the task queue of the WorkerWithoutOptions worker.
+const WorkerWithoutOptionsTaskQueue = "my-task-queue"
+
+// NewWorkerWorkerWithoutOptions creates a worker for the task queue of WorkerWithoutOptions,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterWorkerWithoutOptions.
+func NewWorkerWorkerWithoutOptions(c client.Client, runtimeOpts ...WorkerWithoutOptionsWorkerOption) worker.Worker {
 	opts := worker.Options{}
-	w := worker.New(c, taskQueue, opts)
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, WorkerWithoutOptionsTaskQueue, opts)
+}
+
+// RegisterWorkerWithoutOptions registers the workflows and activities of WorkerWithoutOptions
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterWorkerWithoutOptions(w worker.Registry, impl WorkerWithoutOptionsTemporalClient) {
+}
+
+// StartWorkerWorkerWithoutOptions runs a worker which hosts only WorkerWithoutOptions,
+// until the process receives an interrupt signal.
+func StartWorkerWorkerWithoutOptions(c client.Client, impl WorkerWithoutOptionsTemporalClient, runtimeOpts ...WorkerWithoutOptionsWorkerOption) {
+	w := NewWorkerWorkerWithoutOptions(c, runtimeOpts...)
+	RegisterWorkerWithoutOptions(w, impl)
 
 	if err := w.Run(worker.InterruptCh()); err != nil {
 		log.Fatalln("Failed to start Temporal worker:", err)
@@ -53,5 +104,9 @@ type workerWithoutOptionsTemporalClient struct {
 }
 
 func NewWorkerWithoutOptionsTemporalClient(c client.Client) *WorkerWithoutOptionsTemporalClient {
+	return newWorkerWithoutOptionsTemporalClient(c)
+}
+
+func newWorkerWithoutOptionsTemporalClient(c client.Client) *workerWithoutOptionsTemporalClient {
 	return &workerWithoutOptionsTemporalClient{c}
 }
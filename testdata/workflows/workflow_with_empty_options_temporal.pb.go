@@ -32,17 +32,66 @@ package workflows
 import (
 	context "context"
 	client "go.temporal.io/sdk/client"
+	interceptor "go.temporal.io/sdk/interceptor"
 	worker "go.temporal.io/sdk/worker"
 	workflow "go.temporal.io/sdk/workflow"
 	log "log"
 )
 
-func StartWorkerWorkflowWithEmptyOptions(c client.Client) {
-	taskQueue := "my-task-queue"
+// WorkflowWithEmptyOptionsWorkerOption sets runtime-only worker options, which
+// complement the options in the service's proto definition.
+type WorkflowWithEmptyOptionsWorkerOption func(*worker.Options)
+
+// WithWorkflowWithEmptyOptionsBackgroundActivityContext sets the context which activities can
+// use to access resources which are shared by all the activities in the worker.
+func WithWorkflowWithEmptyOptionsBackgroundActivityContext(ctx context.Context) WorkflowWithEmptyOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.BackgroundActivityContext = ctx
+	}
+}
+
+// WithWorkflowWithEmptyOptionsInterceptors sets the worker interceptors to apply,
+// in addition to the interceptors of the client.
+func WithWorkflowWithEmptyOptionsInterceptors(interceptors ...interceptor.WorkerInterceptor) WorkflowWithEmptyOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.Interceptors = interceptors
+	}
+}
+
+// WithWorkflowWithEmptyOptionsOnFatalError sets a callback which is invoked when
+// the worker encounters an unrecoverable error and stops.
+func WithWorkflowWithEmptyOptionsOnFatalError(f func(error)) WorkflowWithEmptyOptionsWorkerOption {
+	return func(o *worker.Options) {
+		o.OnFatalError = f
+	}
+}
+
+// WorkflowWithEmptyOptionsTaskQueue is the name of the task queue of the WorkflowWithEmptyOptions worker.
+const WorkflowWithEmptyOptionsTaskQueue = "my-task-queue"
+
+// NewWorkerWorkflowWithEmptyOptions creates a worker for the task queue of WorkflowWithEmptyOptions,
+// with the worker options of its proto definition. The worker may also host
+// other services which share the same task queue, see RegisterWorkflowWithEmptyOptions.
+func NewWorkerWorkflowWithEmptyOptions(c client.Client, runtimeOpts ...WorkflowWithEmptyOptionsWorkerOption) worker.Worker {
 	opts := worker.Options{}
-	w := worker.New(c, taskQueue, opts)
+	for _, o := range runtimeOpts {
+		o(&opts)
+	}
+	return worker.New(c, WorkflowWithEmptyOptionsTaskQueue, opts)
+}
 
-	w.RegisterWorkflow(Foo)
+// RegisterWorkflowWithEmptyOptions registers the workflows and activities of WorkflowWithEmptyOptions
+// in the given worker, which may be shared with other services that have the
+// same task queue (and therefore, the same worker options).
+func RegisterWorkflowWithEmptyOptions(w worker.Registry, impl WorkflowWithEmptyOptionsTemporalClient) {
+	w.RegisterWorkflow(impl.Foo)
+}
+
+// StartWorkerWorkflowWithEmptyOptions runs a worker which hosts only WorkflowWithEmptyOptions,
+// until the process receives an interrupt signal.
+func StartWorkerWorkflowWithEmptyOptions(c client.Client, impl WorkflowWithEmptyOptionsTemporalClient, runtimeOpts ...WorkflowWithEmptyOptionsWorkerOption) {
+	w := NewWorkerWorkflowWithEmptyOptions(c, runtimeOpts...)
+	RegisterWorkflowWithEmptyOptions(w, impl)
 
 	if err := w.Run(worker.InterruptCh()); err != nil {
 		log.Fatalln("Failed to start Temporal worker:", err)
@@ -59,6 +108,10 @@ type workflowWithEmptyOptionsTemporalClient struct {
 }
 
 func NewWorkflowWithEmptyOptionsTemporalClient(c client.Client) *WorkflowWithEmptyOptionsTemporalClient {
+	return newWorkflowWithEmptyOptionsTemporalClient(c)
+}
+
+func newWorkflowWithEmptyOptionsTemporalClient(c client.Client) *workflowWithEmptyOptionsTemporalClient {
 	return &workflowWithEmptyOptionsTemporalClient{c}
 }
 
@@ -68,7 +121,9 @@ func NewWorkflowWithEmptyOptionsTemporalClient(c client.Client) *WorkflowWithEmp
 // WorkflowRun to interact with it until completion. For more information, see
 // https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
 func (c *workflowWithEmptyOptionsTemporalClient) StartWorkflowWorkflowWithEmptyOptionsFoo(ctx context.Context, in *FooInput) (client.WorkflowRun, error) {
-	opts := client.StartWorkflowOptions{}
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
 	return c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
 }
 
@@ -78,7 +133,9 @@ func (c *workflowWithEmptyOptionsTemporalClient) StartWorkflowWorkflowWithEmptyO
 // completion, and returns the output/error results. For more information, see
 // https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution.
 func (c *workflowWithEmptyOptionsTemporalClient) ExecuteWorkflowWorkflowWithEmptyOptionsFoo(ctx context.Context, in *FooInput) (*FooOutput, error) {
-	opts := client.StartWorkflowOptions{}
+	opts := client.StartWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	}
 	run, err := c.t.ExecuteWorkflow(ctx, opts, c.Foo, in)
 	if err != nil {
 		return nil, err
@@ -95,7 +152,9 @@ func (c *workflowWithEmptyOptionsTemporalClient) ExecuteWorkflowWorkflowWithEmpt
 // see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
 // and https://docs.temporal.io/workflows#child-workflow.
 func (c *workflowWithEmptyOptionsTemporalClient) StartChildWorkflowWorkflowWithEmptyOptionsFoo(ctx workflow.Context, in *FooInput) workflow.ChildWorkflowFuture {
-	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{})
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
 	return workflow.ExecuteChildWorkflow(ctx, c.Foo, in)
 }
 
@@ -106,8 +165,19 @@ func (c *workflowWithEmptyOptionsTemporalClient) StartChildWorkflowWorkflowWithE
 // see https://docs.temporal.io/dev-guide/go/foundations#start-workflow-execution
 // and https://docs.temporal.io/workflows#child-workflow.
 func (c *workflowWithEmptyOptionsTemporalClient) ExecuteChildWorkflowWorkflowWithEmptyOptionsFoo(ctx workflow.Context, in *FooInput) (*FooOutput, error) {
-	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{})
+	ctx = workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		TaskQueue: "my-task-queue",
+	})
 	var out *FooOutput
 	err := workflow.ExecuteChildWorkflow(ctx, c.Foo, in).Get(ctx, &out)
 	return out, err
 }
+
+// ContinueAsNewWorkflowWithEmptyOptionsFoo returns an error which ends the current run of the Foo
+// workflow, and starts a new run with the same workflow ID, the given input,
+// and the options in its proto definition. The workflow should return it as is.
+// For more information, see https://docs.temporal.io/workflows#continue-as-new.
+func ContinueAsNewWorkflowWithEmptyOptionsFoo(ctx workflow.Context, in *FooInput) error {
+	ctx = workflow.WithWorkflowTaskQueue(ctx, "my-task-queue")
+	return workflow.NewContinueAsNewError(ctx, "Foo", in)
+}
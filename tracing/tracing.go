@@ -0,0 +1,104 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+// Package tracing instruments generated Temporal helpers with OpenTelemetry
+// spans, which are named after the fully qualified rpc of each method, and
+// carry attributes with its proto context. Spans are created with the global
+// tracer provider (see [otel.SetTracerProvider]), so they are no-ops unless
+// the application configures one.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope name of the tracer.
+const ScopeName = "github.com/daabr/protoc-gen-temporal-go/tracing"
+
+// Span attributes.
+const (
+	// ServiceKey is the fully qualified name of the proto service.
+	ServiceKey = attribute.Key("temporal.proto.service")
+	// InputTypeKey is the fully qualified name of the input message type.
+	InputTypeKey = attribute.Key("temporal.proto.input_type")
+	// OutputTypeKey is the fully qualified name of the output message type.
+	OutputTypeKey = attribute.Key("temporal.proto.output_type")
+	// TaskQueueKey is the Temporal task queue of the workflow or activity.
+	TaskQueueKey = attribute.Key("temporal.task_queue")
+	// WorkflowIDKey is the ID of the Temporal workflow, when it's known.
+	WorkflowIDKey = attribute.Key("temporal.workflow_id")
+)
+
+// Method describes an instrumented proto rpc. Generated code defines one for
+// each workflow and activity in services which opt into instrumentation.
+type Method struct {
+	// FullName is the fully qualified name of the rpc, and of its spans.
+	FullName string
+	// Service is the fully qualified name of the rpc's service.
+	Service string
+	// TaskQueue is the task queue of the rpc, if it's known statically.
+	TaskQueue string
+	// InputType and OutputType are the fully qualified names of the rpc's
+	// input and output message types.
+	InputType  string
+	OutputType string
+}
+
+// Start creates a span for the given method, with the given kind: clients
+// use [trace.SpanKindClient], and workers use [trace.SpanKindServer].
+func (m Method) Start(ctx context.Context, kind trace.SpanKind) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{
+		ServiceKey.String(m.Service),
+		InputTypeKey.String(m.InputType),
+		OutputTypeKey.String(m.OutputType),
+	}
+	if m.TaskQueue != "" {
+		attrs = append(attrs, TaskQueueKey.String(m.TaskQueue))
+	}
+
+	tracer := otel.GetTracerProvider().Tracer(ScopeName)
+	return tracer.Start(ctx, m.FullName, trace.WithSpanKind(kind), trace.WithAttributes(attrs...))
+}
+
+// SetWorkflowID adds the given workflow ID to the span's attributes.
+func SetWorkflowID(span trace.Span, id string) {
+	if id != "" {
+		span.SetAttributes(WorkflowIDKey.String(id))
+	}
+}
+
+// End ends the given span, after recording the given error (if it's not nil)
+// and setting the span's status accordingly.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
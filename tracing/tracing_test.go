@@ -0,0 +1,146 @@
+/*
+MIT License
+
+Copyright (c) 2023 Daniel Abraham
+
+Permission is hereby granted, free of charge, to any person obtaining a copy
+of this software and associated documentation files (the "Software"), to deal
+in the Software without restriction, including without limitation the rights
+to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+copies of the Software, and to permit persons to whom the Software is
+furnished to do so, subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+SOFTWARE.
+*/
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var method = Method{
+	FullName:   "foo.Service.Bar",
+	Service:    "foo.Service",
+	TaskQueue:  "my-task-queue",
+	InputType:  "foo.BarInput",
+	OutputType: "foo.BarOutput",
+}
+
+func record(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	rec := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	return rec
+}
+
+func attributes(s sdktrace.ReadOnlySpan) map[attribute.Key]string {
+	m := map[attribute.Key]string{}
+	for _, kv := range s.Attributes() {
+		m[kv.Key] = kv.Value.Emit()
+	}
+	return m
+}
+
+func TestSpan(t *testing.T) {
+	rec := record(t)
+
+	_, span := method.Start(context.Background(), trace.SpanKindClient)
+	SetWorkflowID(span, "wid")
+	End(span, nil)
+
+	spans := rec.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d ended spans, want 1", len(spans))
+	}
+	s := spans[0]
+	if s.Name() != method.FullName {
+		t.Errorf("span name = %q, want %q", s.Name(), method.FullName)
+	}
+	if s.SpanKind() != trace.SpanKindClient {
+		t.Errorf("span kind = %v, want %v", s.SpanKind(), trace.SpanKindClient)
+	}
+	if s.Status().Code != codes.Unset {
+		t.Errorf("span status = %v, want %v", s.Status().Code, codes.Unset)
+	}
+
+	want := map[attribute.Key]string{
+		ServiceKey:    "foo.Service",
+		InputTypeKey:  "foo.BarInput",
+		OutputTypeKey: "foo.BarOutput",
+		TaskQueueKey:  "my-task-queue",
+		WorkflowIDKey: "wid",
+	}
+	got := attributes(s)
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("attribute %q = %q, want %q", k, got[k], v)
+		}
+	}
+}
+
+func TestSpanError(t *testing.T) {
+	rec := record(t)
+
+	m := method
+	m.TaskQueue = ""
+	_, span := m.Start(context.Background(), trace.SpanKindServer)
+	SetWorkflowID(span, "")
+	End(span, errors.New("boom"))
+
+	spans := rec.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d ended spans, want 1", len(spans))
+	}
+	s := spans[0]
+	if s.Status().Code != codes.Error || s.Status().Description != "boom" {
+		t.Errorf("span status = %+v, want error %q", s.Status(), "boom")
+	}
+	if len(s.Events()) != 1 || s.Events()[0].Name != "exception" {
+		t.Errorf("span events = %v, want a recorded exception", s.Events())
+	}
+
+	got := attributes(s)
+	for _, k := range []attribute.Key{TaskQueueKey, WorkflowIDKey} {
+		if _, ok := got[k]; ok {
+			t.Errorf("unexpected attribute %q", k)
+		}
+	}
+}
+
+func TestChildSpan(t *testing.T) {
+	rec := record(t)
+
+	ctx, parent := method.Start(context.Background(), trace.SpanKindClient)
+	_, child := method.Start(ctx, trace.SpanKindServer)
+	End(child, nil)
+	End(parent, nil)
+
+	spans := rec.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("got %d ended spans, want 2", len(spans))
+	}
+	if spans[0].Parent().SpanID() != spans[1].SpanContext().SpanID() {
+		t.Error("child span isn't a descendant of the parent span")
+	}
+}